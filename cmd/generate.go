@@ -9,24 +9,52 @@ import (
 	"github.com/xRiot45/gocrafting/internal/scaffold"
 )
 
+// registerGenerated wires generated code into the project, e.g. middleware into the router.
+var registerGenerated bool
+
+// configSource is the env file read by the config schematic.
+var configSource string
+
+// withTests overrides the "with_tests" setting of gocrafting-cli.json for a single run.
+var withTests bool
+
 var generateCmd = &cobra.Command{
-	Use:     "generate [schematic] [name]",
+	Use:     "generate [schematic] [name] [args...]",
 	Aliases: []string{"g"},
 	Short:   "Generate boilerplate code based on schematic",
-	Args:    cobra.MinimumNArgs(2),
-	Run: func(_ *cobra.Command, args []string) {
+	Example: "  gocrafting g handler product\n" +
+		"  gocrafting g model user name:string email:string bio:text:optional age:int created_at:time\n" +
+		"  gocrafting g middleware cors --register\n" +
+		"  gocrafting g config\n" +
+		"  gocrafting g config --from .env.production\n" +
+		"  gocrafting g middleware api_key auth\n" +
+		"  gocrafting g service payment --with-tests=false",
+	Args: generateArgs,
+	Run: func(cmd *cobra.Command, args []string) {
 		schematic := args[0]
-		name := args[1]
+		name, extra := "", []string(nil)
+		if len(args) > 1 {
+			name, extra = args[1], args[2:]
+		}
 
 		meta, err := core.LoadMetadata()
 		if err != nil {
 			handleError(fmt.Errorf("gocrafting-cli.json not found. Are you in the root of the project?"))
 		}
 
-		fmt.Printf("🛠  Scaffolding %s '%s' for %s...\n", schematic, name, meta.SelectedFramework)
+		opts := scaffold.Options{Register: registerGenerated, WithTests: meta.TestsEnabled(), From: configSource}
+		if cmd.Flags().Changed("with-tests") {
+			opts.WithTests = withTests
+		}
+
+		if name == "" {
+			fmt.Printf("🛠  Scaffolding %s for %s...\n", schematic, meta.SelectedFramework)
+		} else {
+			fmt.Printf("🛠  Scaffolding %s '%s' for %s...\n", schematic, name, meta.SelectedFramework)
+		}
 		start := time.Now()
 
-		if err := scaffold.Run(meta, schematic, name); err != nil {
+		if err := scaffold.Run(meta, schematic, name, extra, opts); err != nil {
 			handleError(err)
 		}
 
@@ -34,6 +62,18 @@ var generateCmd = &cobra.Command{
 	},
 }
 
+// generateArgs requires a schematic and a name, except for "config" which always generates config/config.go.
+func generateArgs(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 && (args[0] == "config" || args[0] == "conf") {
+		return cobra.RangeArgs(1, 2)(cmd, args)
+	}
+	return cobra.MinimumNArgs(2)(cmd, args)
+}
+
 func init() {
+	generateCmd.Flags().BoolVar(&registerGenerated, "register", false, "register the generated middleware in the router setup")
+	generateCmd.Flags().StringVar(&configSource, "from", "", "env file the config schematic reads its keys from (default .env.example)")
+	generateCmd.Flags().BoolVar(&withTests, "with-tests", true, `generate tests next to each artifact (default from "with_tests" in gocrafting-cli.json)`)
+
 	rootCmd.AddCommand(generateCmd)
 }
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/database"
+	"github.com/xRiot45/gocrafting/internal/scaffold"
+)
+
+// envFile is the env file used to resolve DB_* settings for database commands.
+var envFile string
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage database migrations (create|up|down|version)",
+	Example: "  gocrafting migrate create create_users_table\n" +
+		"  gocrafting migrate up\n" +
+		"  gocrafting migrate down 2\n" +
+		"  gocrafting migrate version",
+}
+
+var migrateCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a new timestamped up/down migration pair",
+	Args:  cobra.ExactArgs(1),
+	Run: func(_ *cobra.Command, args []string) {
+		meta := loadProjectMetadata()
+
+		if err := scaffold.GenerateMigration(meta, args[0]); err != nil {
+			handleError(err)
+		}
+	},
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up [steps]",
+	Short: "Apply pending migrations (all by default)",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(_ *cobra.Command, args []string) {
+		steps := parseSteps(args)
+		migrator, db := openMigrator()
+		defer closeDatabase(db)
+
+		start := time.Now()
+		applied, err := migrator.Up(context.Background(), steps)
+		for _, m := range applied {
+			fmt.Printf("   ⬆  Applied %d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			handleError(err)
+		}
+
+		if len(applied) == 0 {
+			fmt.Println("✅ Database is already up to date")
+			return
+		}
+		fmt.Printf("✅ Applied %d migration(s) in %s\n", len(applied), time.Since(start))
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down [steps]",
+	Short: "Roll back applied migrations (one by default)",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(_ *cobra.Command, args []string) {
+		steps := parseSteps(args)
+		migrator, db := openMigrator()
+		defer closeDatabase(db)
+
+		start := time.Now()
+		reverted, err := migrator.Down(context.Background(), steps)
+		for _, m := range reverted {
+			fmt.Printf("   ⬇  Reverted %d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			handleError(err)
+		}
+
+		if len(reverted) == 0 {
+			fmt.Println("✅ Nothing to roll back")
+			return
+		}
+		fmt.Printf("✅ Reverted %d migration(s) in %s\n", len(reverted), time.Since(start))
+	},
+}
+
+var migrateVersionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the current migration version",
+	Args:  cobra.NoArgs,
+	Run: func(_ *cobra.Command, _ []string) {
+		migrator, db := openMigrator()
+		defer closeDatabase(db)
+
+		version, err := migrator.Version(context.Background())
+		if err != nil {
+			handleError(err)
+		}
+
+		if version == 0 {
+			fmt.Println("No migrations applied yet")
+			return
+		}
+		fmt.Printf("Current version: %d\n", version)
+	},
+}
+
+func init() {
+	migrateCmd.PersistentFlags().StringVar(&envFile, "env-file", ".env", "env file used to resolve DB_* settings")
+
+	migrateCmd.AddCommand(migrateCreateCmd, migrateUpCmd, migrateDownCmd, migrateVersionCmd)
+	rootCmd.AddCommand(migrateCmd)
+}
+
+// loadProjectMetadata reads gocrafting-cli.json from the current directory or exits.
+func loadProjectMetadata() *core.ProjectMetadata {
+	meta, err := core.LoadMetadata()
+	if err != nil {
+		handleError(fmt.Errorf("gocrafting-cli.json not found. Are you in the root of the project?"))
+	}
+	return meta
+}
+
+// openProjectDatabase connects to the project's database using its metadata and env file.
+func openProjectDatabase() (*core.ProjectMetadata, *sql.DB) {
+	meta := loadProjectMetadata()
+
+	env, err := database.LoadEnv(envFile)
+	if err != nil {
+		handleError(err)
+	}
+
+	db, err := database.Open(meta.SelectedDatabaseDriver, env, meta.ProjectName)
+	if err != nil {
+		handleError(err)
+	}
+
+	return meta, db
+}
+
+// openMigrator connects to the database and prepares a migrator for the project.
+func openMigrator() (*database.Migrator, *sql.DB) {
+	meta, db := openProjectDatabase()
+	return database.NewMigrator(db, meta.SelectedDatabaseDriver, database.MigrationsDir), db
+}
+
+// closeDatabase closes the connection, reporting (but not failing on) errors.
+func closeDatabase(db *sql.DB) {
+	if err := db.Close(); err != nil {
+		fmt.Printf("Warning: failed to close database: %v\n", err)
+	}
+}
+
+// parseSteps reads the optional [steps] argument.
+func parseSteps(args []string) int {
+	if len(args) == 0 {
+		return 0
+	}
+
+	steps, err := strconv.Atoi(args[0])
+	if err != nil || steps < 1 {
+		handleError(fmt.Errorf("steps must be a positive number, got '%s'", args[0]))
+	}
+	return steps
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/shell"
 )
 
 // --- 1. STYLING CONSTANTS ---
@@ -37,11 +39,29 @@ type schematicEntry struct {
 var rootCmd = &cobra.Command{
 	Use:   "gocrafting",
 	Short: "The Enterprise-Grade Go Architecture Generator",
+	PersistentPreRun: func(_ *cobra.Command, _ []string) {
+		if offline {
+			enableOffline()
+		}
+	},
 	Run: func(cmd *cobra.Command, _ []string) {
 		renderCustomHelp(cmd)
 	},
 }
 
+// offline resolves Go modules without network access, see enableOffline.
+var offline bool
+
+// enableOffline makes every go command resolve modules from the module cache, or the
+// GOPROXY=file:// directory when one is configured, installing the versions pinned in the dependency registry.
+func enableOffline() {
+	executor, err := shell.NewOfflineExecutor("", core.PinnedVersions())
+	if err != nil {
+		handleError(err)
+	}
+	shell.SetExecutor(executor)
+}
+
 // --- 4. INITIALIZATION ---
 
 func init() {
@@ -49,6 +69,8 @@ func init() {
 		renderCustomHelp(cmd)
 	})
 
+	rootCmd.PersistentFlags().BoolVar(&offline, "offline", false, "resolve Go modules only from the module cache or a GOPROXY=file:// directory")
+
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 }
 
@@ -103,6 +125,11 @@ func renderCustomHelp(_ *cobra.Command) {
 	// SECTION 5: EXTENSIONS
 	printSection("EXTENSION", []helpEntry{
 		{"add <lib>", "Install a library & generate setup code (e.g. 'add redis')."},
+		{"templates eject <path>", "Copy a default template into .gocrafting/templates to customize it."},
+		{"templates install <path>", "Install a template pack from a local folder or tarball."},
+		{"templates list", "List built-in templates and installed template packs."},
+		{"templates lint", "Render every template for all project combinations and check the generated Go."},
+		{"verify", "Generate every project combination and check it vets and builds."},
 	})
 
 	// SECTION 6: FLAGS
@@ -110,6 +137,7 @@ func renderCustomHelp(_ *cobra.Command) {
 		{"-h, --help", "Show this help message."},
 		{"-v, --version", "Show CLI version."},
 		{"--verbose", "Enable detailed logging."},
+		{"--offline", "Install pinned modules from the module cache or GOPROXY=file://."},
 	})
 
 	// EXAMPLES
@@ -173,9 +201,10 @@ func printSchematicsList() {
 
 	// Group 2: Data Layer
 	printGroup("DATA LAYER", []schematicEntry{
-		{"repository", "repo", "Data Access Layer (SQL/Gorm implementation)"},
+		{"repository", "repo", "Data Access Layer (database/sql or MongoDB)"},
 		{"model", "m", "Database Entity & DTO structs"},
 		{"migration", "mig", "Database schema migration file"},
+		{"seeder", "seed", "Database seed fixture (YAML)"},
 	})
 
 	// Group 3: System & Config
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xRiot45/gocrafting/internal/database"
+)
+
+// seedReset clears fixture tables and their seed records before seeding again.
+var seedReset bool
+
+var seedCmd = &cobra.Command{
+	Use:   "seed",
+	Short: "Run database seeders to populate initial data",
+	Long: "Loads the SQL files and YAML/JSON fixtures from seeds/ in file-name order.\n" +
+		"Every file is recorded after it succeeds, so running seed again only loads new files.\n" +
+		"--reset empties the fixture tables and loads the fixtures again; SQL seeds are not re-run.",
+	Example: "  gocrafting seed\n  gocrafting seed --reset",
+	Args:    cobra.NoArgs,
+	Run: func(_ *cobra.Command, _ []string) {
+		meta, db := openProjectDatabase()
+		defer closeDatabase(db)
+
+		seeder := database.NewSeeder(db, meta.SelectedDatabaseDriver, database.SeedsDir)
+		ctx := context.Background()
+		start := time.Now()
+
+		if seedReset {
+			if err := seeder.Reset(ctx); err != nil {
+				handleError(err)
+			}
+			fmt.Println("   🧹 Cleared fixture tables and fixture seed records")
+		}
+
+		seeded, err := seeder.Run(ctx)
+		for _, s := range seeded {
+			fmt.Printf("   🌱 Seeded %s\n", s.Name)
+		}
+		if err != nil {
+			handleError(err)
+		}
+
+		if len(seeded) == 0 {
+			fmt.Println("✅ All seeders have already been applied")
+			return
+		}
+		fmt.Printf("✅ Applied %d seeder(s) in %s\n", len(seeded), time.Since(start))
+	},
+}
+
+func init() {
+	seedCmd.Flags().BoolVar(&seedReset, "reset", false, "clear fixture tables and reload fixtures (SQL seeds are not re-run)")
+	seedCmd.Flags().StringVar(&envFile, "env-file", ".env", "env file used to resolve DB_* settings")
+
+	rootCmd.AddCommand(seedCmd)
+}
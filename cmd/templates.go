@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/xRiot45/gocrafting/internal/generators"
+	"github.com/xRiot45/gocrafting/internal/lint"
+	"github.com/xRiot45/gocrafting/internal/packs"
+	"github.com/xRiot45/gocrafting/internal/scaffold"
+	"github.com/xRiot45/gocrafting/internal/templates"
+)
+
+var (
+	// ejectUser writes ejected templates to the user-level override directory instead of the project.
+	ejectUser bool
+	// ejectForce overwrites templates that were already ejected.
+	ejectForce bool
+	// installProject installs a pack into .gocrafting/packs instead of the user-level pack directory.
+	installProject bool
+	// installForce replaces an installed pack with the same name.
+	installForce bool
+)
+
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Manage template overrides and template packs",
+	Long: "Templates are looked up in .gocrafting/templates/, then ~/.config/gocrafting/templates/,\n" +
+		"then the built-in defaults, using the same relative path (e.g. small/handlers/gin.tmpl).\n\n" +
+		"A .tmpl file is only generated when the 'when' expression of its front-matter is true:\n\n" +
+		"  ---\n  when: ne .SelectedDatabaseDriver \"None\"\n  ---\n\n" +
+		"A folder holding a " + templates.WhenFile + " file with such an expression is skipped as a whole when it is false.",
+}
+
+var templatesEjectCmd = &cobra.Command{
+	Use:   "eject [path]",
+	Short: "Copy a default template (or folder) into an override directory for editing",
+	Example: "  gocrafting templates eject small/handlers/gin.tmpl\n" +
+		"  gocrafting templates eject common/docker/Dockerfile.tmpl --user\n" +
+		"  gocrafting templates eject common/middleware",
+	Args: cobra.ExactArgs(1),
+	Run: func(_ *cobra.Command, args []string) {
+		destDir := templates.ProjectDir
+		if ejectUser {
+			userDir, err := templates.UserDir()
+			if err != nil {
+				handleError(err)
+			}
+			destDir = userDir
+		}
+
+		defaults := templates.Merge(templates.FS, scaffold.Templates())
+		written, err := templates.Eject(defaults, args[0], destDir, ejectForce)
+		for _, file := range written {
+			fmt.Printf("   Ejected %s\n", file)
+		}
+		if err != nil {
+			handleError(err)
+		}
+
+		fmt.Printf("✅ Edit the copies in %s, they now take precedence over the defaults\n", destDir)
+	},
+}
+
+var templatesInstallCmd = &cobra.Command{
+	Use:   "install [path]",
+	Short: "Install a template pack from a local directory or tarball",
+	Long: "A template pack is a directory (or .tar/.tar.gz/.tgz archive) with a " + packs.ManifestFile + " manifest\n" +
+		"declaring its scale, template name, frameworks, database drivers, dependencies and files.\n" +
+		"Installed packs appear in the 'gocrafting new' wizard next to the built-in templates.",
+	Example: "  gocrafting templates install ./event-consumer\n" +
+		"  gocrafting templates install ./event-consumer.tar.gz --force\n" +
+		"  gocrafting templates install ./event-consumer --project",
+	Args: cobra.ExactArgs(1),
+	Run: func(_ *cobra.Command, args []string) {
+		destRoot := packs.ProjectDir
+		if !installProject {
+			userDir, err := packs.UserDir()
+			if err != nil {
+				handleError(err)
+			}
+			destRoot = userDir
+		}
+
+		pack, err := packs.Install(args[0], destRoot, installForce)
+		if err != nil {
+			handleError(err)
+		}
+
+		fmt.Printf("✅ Installed template pack '%s' (%s scale) in %s\n", pack.Template, pack.Scale, pack.Dir)
+	},
+}
+
+var templatesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List built-in templates and installed template packs",
+	Args:  cobra.NoArgs,
+	Run: func(_ *cobra.Command, _ []string) {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprintln(w, "TEMPLATE\tSCALE\tSOURCE\tFRAMEWORKS\tDATABASES")
+
+		installed := packs.List()
+		isPack := map[string]bool{}
+		for _, pack := range installed {
+			isPack[pack.Scale+"/"+pack.Template] = true
+		}
+
+		for _, scale := range []string{"Small", "Medium", "Enterprise"} {
+			provider, err := generators.GetProvider(scale)
+			if err != nil {
+				continue
+			}
+			for _, template := range provider.GetTemplates() {
+				if isPack[scale+"/"+template] {
+					continue
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\tbuilt-in\t%s\t%s\n", template, scale,
+					listOrDash(provider.GetFrameworks(template)), listOrDash(provider.GetDatabaseDrivers(template)))
+			}
+		}
+
+		for _, pack := range installed {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", pack.Template, pack.Scale, pack.Dir,
+				listOrDash(pack.Frameworks), listOrDash(pack.DatabaseDrivers))
+		}
+		_ = w.Flush()
+	},
+}
+
+var templatesLintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Execute every template against all valid project combinations and check the generated Go code",
+	Long: "Renders the built-in templates, overrides and installed packs for every template, framework, database,\n" +
+		"testing style, logger and add-on combination offered by the wizard, and the schematic templates with sample data.\n" +
+		"Generated Go code is checked with go/parser and gofmt; failures report the template line and combination.",
+	Args: cobra.NoArgs,
+	Run: func(_ *cobra.Command, _ []string) {
+		report := lint.Run()
+
+		for _, note := range report.Skipped {
+			fmt.Printf("   ⚠️  Skipped %s\n", note)
+		}
+
+		for _, issue := range report.Issues {
+			location := issue.Template
+			if issue.Line > 0 {
+				location = fmt.Sprintf("%s:%d", issue.Template, issue.Line)
+			}
+			fmt.Printf("❌ %s\n   %s\n   combination: %s", location, issue.Message, lint.Describe(issue.Config))
+			if issue.Count > 1 {
+				fmt.Printf(" (and %d more)", issue.Count-1)
+			}
+			fmt.Println()
+		}
+
+		if len(report.Issues) > 0 {
+			handleError(fmt.Errorf("%d template issue(s) found in %d combinations", len(report.Issues), report.Combinations))
+		}
+
+		fmt.Printf("✅ %d template renders over %d combinations, no issues found\n", report.Renders, report.Combinations)
+	},
+}
+
+// listOrDash joins values for a table cell, "-" when there are none.
+func listOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ", ")
+}
+
+func init() {
+	templatesEjectCmd.Flags().BoolVar(&ejectUser, "user", false, "eject into ~/.config/gocrafting/templates instead of .gocrafting/templates")
+	templatesEjectCmd.Flags().BoolVar(&ejectForce, "force", false, "overwrite templates that were already ejected")
+
+	templatesInstallCmd.Flags().BoolVar(&installProject, "project", false, "install into .gocrafting/packs instead of ~/.config/gocrafting/packs")
+	templatesInstallCmd.Flags().BoolVar(&installForce, "force", false, "replace an installed pack with the same name")
+
+	templatesCmd.AddCommand(templatesEjectCmd, templatesInstallCmd, templatesListCmd, templatesLintCmd)
+	rootCmd.AddCommand(templatesCmd)
+}
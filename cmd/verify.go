@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/lint"
+	"github.com/xRiot45/gocrafting/internal/verify"
+)
+
+// verifyOptions holds the flags of 'gocrafting verify'.
+var verifyOptions verify.Options
+
+// verifyOutputLines limits the compiler output printed for each failure.
+const verifyOutputLines = 15
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Generate project combinations and check that they pass go vet and go build",
+	Long: "Generates every selected template, framework, database, testing style, logger and add-on combination\n" +
+		"into a temporary directory and runs 'go vet ./...' and 'go build ./...' on each project.\n\n" +
+		"Dependencies are resolved offline like 'gocrafting new --offline': the pinned versions are installed from\n" +
+		"the local module cache, a GOPROXY=file:// directory, or the module proxy directory given with --proxy.\n" +
+		"The command fails when a combination does not compile, so it can run in CI.",
+	Example: "  gocrafting verify\n" +
+		"  gocrafting verify --template \"Fast HTTP\" --framework Gin --database None\n" +
+		"  gocrafting verify --proxy ./goproxy --jobs 4",
+	Args: cobra.NoArgs,
+	Run: func(_ *cobra.Command, _ []string) {
+		combinations, _ := verify.Select(verifyOptions)
+		if len(combinations) == 0 {
+			handleError(fmt.Errorf("no combinations match the selected template, framework, database, testing and logger"))
+		}
+		fmt.Printf("🔍 Verifying %d combinations...\n", len(combinations))
+
+		report, err := verify.Run(verifyOptions, func(config core.ProjectConfig, stage string) {
+			if stage == "" {
+				fmt.Printf("   ✔ %s\n", lint.Describe(config))
+				return
+			}
+			fmt.Printf("   ✘ %s (%s failed)\n", lint.Describe(config), stage)
+		})
+		if err != nil {
+			handleError(err)
+		}
+
+		for _, note := range report.Skipped {
+			fmt.Printf("   ⚠️  Skipped %s\n", note)
+		}
+
+		for _, failure := range report.Failures {
+			fmt.Printf("\n❌ %s failed for %s", failure.Stage, lint.Describe(failure.Configs[0]))
+			if len(failure.Configs) > 1 {
+				fmt.Printf(" (and %d more)", len(failure.Configs)-1)
+			}
+			fmt.Println()
+			for _, line := range firstLines(failure.Output, verifyOutputLines) {
+				fmt.Printf("   %s\n", line)
+			}
+		}
+
+		if verifyOptions.Keep {
+			fmt.Printf("\n📁 Generated projects kept in %s\n", report.Dir)
+		}
+
+		if len(report.Failures) > 0 {
+			handleError(fmt.Errorf("%d of %d combinations failed to generate or compile", report.Combinations-report.Passed, report.Combinations))
+		}
+
+		fmt.Printf("✅ %d combinations generated, vetted and built\n", report.Combinations)
+	},
+}
+
+// firstLines returns up to n lines of text, with a marker when lines were cut.
+func firstLines(text string, n int) []string {
+	lines := strings.Split(text, "\n")
+	if len(lines) <= n {
+		return lines
+	}
+	return append(lines[:n], fmt.Sprintf("... %d more lines", len(lines)-n))
+}
+
+func init() {
+	verifyCmd.Flags().StringSliceVar(&verifyOptions.Templates, "template", nil, "only verify these templates (e.g. \"Fast HTTP\")")
+	verifyCmd.Flags().StringSliceVar(&verifyOptions.Frameworks, "framework", nil, "only verify these frameworks (e.g. Gin,Fiber)")
+	verifyCmd.Flags().StringSliceVar(&verifyOptions.Databases, "database", nil, "only verify these database drivers (e.g. None,PostgreSQL)")
+	verifyCmd.Flags().StringSliceVar(&verifyOptions.Testing, "testing", nil, "only verify these testing styles")
+	verifyCmd.Flags().StringSliceVar(&verifyOptions.Loggers, "logger", nil, "only verify these loggers")
+	verifyCmd.Flags().StringVar(&verifyOptions.Proxy, "proxy", "", "resolve modules from this module proxy directory instead of the module cache")
+	verifyCmd.Flags().IntVar(&verifyOptions.Jobs, "jobs", runtime.NumCPU(), "number of projects checked in parallel")
+	verifyCmd.Flags().BoolVar(&verifyOptions.Keep, "keep", false, "keep the generated projects for inspection")
+
+	rootCmd.AddCommand(verifyCmd)
+}
@@ -3,20 +3,57 @@ package core
 
 // ProjectConfig stores the data collected from the user via the TUI.
 type ProjectConfig struct {
-	ProjectName            string
-	ModuleName             string
-	ProjectScale           string
-	SelectedTemplate       string
-	SelectedFramework      string
-	SelectedDatabaseDriver string
-	SelectedAddons         []string
+	ProjectName              string
+	ModuleName               string
+	ProjectScale             string
+	SelectedTemplate         string
+	SelectedFramework        string
+	SelectedDatabaseDriver   string
+	SelectedTestingFramework string
+	SelectedLogger           string
+	SelectedAddons           []string
 }
 
 // HasAddon checks if the given addonName is present in the SelectedAddons slice.
+// addonName is either the label stored by the wizard or the add-on ID, e.g. "docker".
 // It returns true if the addonName is found, and false otherwise.
 func (c ProjectConfig) HasAddon(addonName string) bool {
-	for _, a := range c.SelectedAddons {
-		if a == addonName {
+	return hasAddon(c.SelectedAddons, addonName)
+}
+
+// DatabaseDriver returns the selected database driver, e.g. "PostgreSQL" or "None".
+func (c ProjectConfig) DatabaseDriver() string {
+	return c.SelectedDatabaseDriver
+}
+
+// Framework returns the selected web framework, e.g. "Gin".
+func (c ProjectConfig) Framework() string {
+	return c.SelectedFramework
+}
+
+// TestingFramework returns the testing style of the project, defaulting to the standard library.
+func (c ProjectConfig) TestingFramework() string {
+	if c.SelectedTestingFramework == "" {
+		return TestingStdlib
+	}
+	return c.SelectedTestingFramework
+}
+
+// Testify reports whether generated tests use Testify assertions.
+func (c ProjectConfig) Testify() bool {
+	return c.SelectedTestingFramework == TestingTestify
+}
+
+// Ginkgo reports whether generated tests are Ginkgo specs with Gomega matchers.
+func (c ProjectConfig) Ginkgo() bool {
+	return c.SelectedTestingFramework == TestingGinkgo
+}
+
+// hasAddon reports whether selected contains addonName, matching labels and IDs.
+func hasAddon(selected []string, addonName string) bool {
+	id := AddonID(addonName)
+	for _, a := range selected {
+		if a == addonName || (id != "" && AddonID(a) == id) {
 			return true
 		}
 	}
@@ -1,93 +1,146 @@
 package core
 
-// DependenciesRegistry maps feature keys to their corresponding Go package import paths.
-var DependenciesRegistry = map[string][]string{
+// Dependency is a Go module installed into generated projects, pinned so every generation of the
+// same preset resolves the same versions and go.sum.
+type Dependency struct {
+	// Module is the module path, e.g. "github.com/gin-gonic/gin".
+	Module string
+	// Version is the version constraint passed to go get, an exact version such as "v1.12.0".
+	Version string
+	// Packages are sub-packages installed instead of the module root, relative to the module path,
+	// e.g. "mongo" for "go.mongodb.org/mongo-driver/mongo".
+	Packages []string
+}
+
+// Args returns the go get arguments installing the dependency, e.g. "github.com/gin-gonic/gin@v1.12.0".
+func (d Dependency) Args() []string {
+	if len(d.Packages) == 0 {
+		return []string{d.Module + "@" + d.Version}
+	}
+
+	args := make([]string, 0, len(d.Packages))
+	for _, pkg := range d.Packages {
+		args = append(args, d.Module+"/"+pkg+"@"+d.Version)
+	}
+	return args
+}
+
+// DependenciesRegistry maps feature keys to the Go modules they require.
+var DependenciesRegistry = map[string][]Dependency{
 	// Web Frameworks
 	"Gin": {
-		"github.com/gin-gonic/gin",
+		{Module: "github.com/gin-gonic/gin", Version: "v1.12.0"},
 	},
 	"Fiber": {
-		"github.com/gofiber/fiber/v2",
+		{Module: "github.com/gofiber/fiber/v2", Version: "v2.52.15"},
 	},
 	"Echo": {
-		"github.com/labstack/echo/v4",
+		{Module: "github.com/labstack/echo/v4", Version: "v4.15.4"},
 	},
 	"Chi": {
-		"github.com/go-chi/chi/v5",
+		{Module: "github.com/go-chi/chi/v5", Version: "v5.3.1"},
 	},
 
 	// Database Drivers
 	"SQLite": {
-		"modernc.org/sqlite",
+		{Module: "modernc.org/sqlite", Version: "v1.60.1"},
 	},
 	"PostgreSQL": {
-		"github.com/lib/pq",
+		{Module: "github.com/lib/pq", Version: "v1.12.3"},
 	},
 	"MySQL": {
-		"github.com/go-sql-driver/mysql",
+		{Module: "github.com/go-sql-driver/mysql", Version: "v1.10.1"},
 	},
 	"MongoDB": {
-		"go.mongodb.org/mongo-driver/mongo",
+		{Module: "go.mongodb.org/mongo-driver", Version: "v1.17.10", Packages: []string{"mongo"}},
 	},
 
 	// ORM
 	"Gorm": {
-		"gorm.io/gorm",
+		{Module: "gorm.io/gorm", Version: "v1.31.2"},
 	},
 	"GormPostgres": {
-		"gorm.io/driver/postgres",
+		{Module: "gorm.io/driver/postgres", Version: "v1.6.2"},
 	},
 	"GormMySQL": {
-		"gorm.io/driver/mysql",
+		{Module: "gorm.io/driver/mysql", Version: "v1.6.0"},
 	},
 	"GormSQLite": {
-		"gorm.io/driver/sqlite",
+		{Module: "gorm.io/driver/sqlite", Version: "v1.6.0"},
 	},
 
 	// CLI Libraries
 	"Cobra": {
-		"github.com/spf13/cobra",
+		{Module: "github.com/spf13/cobra", Version: "v1.10.2"},
 	},
 	"Viper": {
-		"github.com/spf13/viper",
+		{Module: "github.com/spf13/viper", Version: "v1.21.0"},
 	},
 
 	// Bot Libraries
 	"TelegramBotAPI": {
-		"github.com/go-telegram-bot-api/telegram-bot-api/v5",
+		{Module: "github.com/go-telegram-bot-api/telegram-bot-api/v5", Version: "v5.5.1"},
 	},
 
 	// Environment Variable Management
 	"Godotenv": {
-		"github.com/joho/godotenv",
+		{Module: "github.com/joho/godotenv", Version: "v1.5.1"},
+	},
+
+	// Scheduling
+	"Cron": {
+		{Module: "github.com/robfig/cron/v3", Version: "v3.0.1"},
+	},
+
+	// RPC
+	"GRPC": {
+		{Module: "google.golang.org/grpc", Version: "v1.84.0"},
+		{Module: "google.golang.org/protobuf", Version: "v1.36.12"},
+	},
+	// Installed with go install by 'make proto-tools' (protoc-gen-go comes from google.golang.org/protobuf)
+	"ProtocGenGoGRPC": {
+		{Module: "google.golang.org/grpc/cmd/protoc-gen-go-grpc", Version: "v1.6.0"},
 	},
 
 	// Testing Frameworks
 	"Testify": {
-		"github.com/stretchr/testify",
+		{Module: "github.com/stretchr/testify", Version: "v1.12.1"},
 	},
 	"Ginkgo": {
-		"github.com/onsi/ginkgo/v2",
+		{Module: "github.com/onsi/ginkgo/v2", Version: "v2.33.0"},
 	},
 	"Gomega": {
-		"github.com/onsi/gomega",
+		{Module: "github.com/onsi/gomega", Version: "v1.44.0"},
 	},
 
 	// Logging Frameworks
 	"Zap": {
-		"go.uber.org/zap",
+		{Module: "go.uber.org/zap", Version: "v1.28.0"},
 	},
 	"Logrus": {
-		"github.com/sirupsen/logrus",
+		{Module: "github.com/sirupsen/logrus", Version: "v1.10.2"},
 	},
 }
 
-// GetPackages returns the list of packages for the given key from the DependenciesRegistry.
+// GetPackages returns the go get arguments for the given key from the DependenciesRegistry,
+// each pinned to its version, e.g. "github.com/gin-gonic/gin@v1.12.0".
 // If the key does not exist, it returns an empty slice instead of nil for safety.
 func GetPackages(key string) []string {
-	if packages, exists := DependenciesRegistry[key]; exists {
-		return packages
+	packages := []string{}
+	for _, dependency := range DependenciesRegistry[key] {
+		packages = append(packages, dependency.Args()...)
 	}
+	return packages
+}
 
-	return []string{}
+// PinnedVersions returns the version of every module in the DependenciesRegistry, keyed by module path,
+// e.g. "github.com/gin-gonic/gin" -> "v1.12.0".
+func PinnedVersions() map[string]string {
+	versions := map[string]string{}
+	for _, dependencies := range DependenciesRegistry {
+		for _, dependency := range dependencies {
+			versions[dependency.Module] = dependency.Version
+		}
+	}
+	return versions
 }
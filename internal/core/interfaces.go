@@ -13,6 +13,10 @@ type FeatureProvider interface {
 	// Mengembalikan daftar opsi database untuk template tertentu
 	GetDatabaseDrivers(template string) []string
 
+	// Mengembalikan folder template project (misal "small/simple-api") untuk template tertentu,
+	// kosong jika template berasal dari template pack
+	GetTemplateDir(template string) string
+
 	// Menjalankan logika generate project
 	Generate(config ProjectConfig) error
 }
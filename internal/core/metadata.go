@@ -2,21 +2,59 @@ package core
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
+	"path/filepath"
 	"time"
+
+	"golang.org/x/mod/modfile"
 )
 
 // ProjectMetadata menyimpan state konfigurasi project.
 type ProjectMetadata struct {
-	CLIVersion             string    `json:"cli_version"`
-	ProjectName            string    `json:"project_name"`
-	ModuleName             string    `json:"module_name"`
-	ProjectScale           string    `json:"project_scale"`
-	SelectedTemplate       string    `json:"selected_template"`
-	SelectedFramework      string    `json:"selected_framework"`
-	SelectedDatabaseDriver string    `json:"selected_database_driver"`
-	SelectedAddons         []string  `json:"selected_addons"`
-	CreatedAt              time.Time `json:"created_at"`
+	CLIVersion             string `json:"cli_version"`
+	ProjectName            string `json:"project_name"`
+	ModuleName             string `json:"module_name"`
+	ProjectScale           string `json:"project_scale"`
+	SelectedTemplate       string `json:"selected_template"`
+	SelectedFramework      string `json:"selected_framework"`
+	SelectedDatabaseDriver string `json:"selected_database_driver"`
+	// SelectedTestingFramework is the style of generated tests; empty (older projects) means the standard library.
+	SelectedTestingFramework string `json:"selected_testing_framework,omitempty"`
+	// SelectedLogger is the library behind the generated logger package; empty (older projects) means slog.
+	SelectedLogger string   `json:"selected_logger,omitempty"`
+	SelectedAddons []string `json:"selected_addons"`
+	// WithTests controls whether schematics generate tests; nil (older projects) means enabled.
+	WithTests *bool     `json:"with_tests,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// Dependencies are the module versions resolved in go.mod, e.g. "github.com/gin-gonic/gin": "v1.12.0",
+	// so the project can be reproduced with the same versions.
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+// TestsEnabled reports whether schematics should generate tests for this project.
+func (m ProjectMetadata) TestsEnabled() bool {
+	return m.WithTests == nil || *m.WithTests
+}
+
+// HasAddon checks if the project was created with the add-on, given its wizard label or ID.
+func (m ProjectMetadata) HasAddon(addonName string) bool {
+	return hasAddon(m.SelectedAddons, addonName)
+}
+
+// Config returns the project settings recorded in the metadata, the data model shared by every template.
+func (m ProjectMetadata) Config() ProjectConfig {
+	return ProjectConfig{
+		ProjectName:              m.ProjectName,
+		ModuleName:               m.ModuleName,
+		ProjectScale:             m.ProjectScale,
+		SelectedTemplate:         m.SelectedTemplate,
+		SelectedFramework:        m.SelectedFramework,
+		SelectedDatabaseDriver:   m.SelectedDatabaseDriver,
+		SelectedTestingFramework: m.TestingFramework(),
+		SelectedLogger:           m.Logger(),
+		SelectedAddons:           m.SelectedAddons,
+	}
 }
 
 // SaveMetadata menulis file gocrafting-cli.json ke root project
@@ -30,7 +68,12 @@ func SaveMetadata(path string, meta ProjectMetadata) error {
 
 // LoadMetadata membaca file gocrafting-cli.json (dipakai command generate)
 func LoadMetadata() (*ProjectMetadata, error) {
-	file, err := os.ReadFile("gocrafting-cli.json")
+	return ReadMetadata(".")
+}
+
+// ReadMetadata membaca file gocrafting-cli.json dari root project di path
+func ReadMetadata(path string) (*ProjectMetadata, error) {
+	file, err := os.ReadFile(filepath.Join(path, "gocrafting-cli.json")) // #nosec G304 -- path is the project being generated.
 	if err != nil {
 		return nil, err
 	}
@@ -41,3 +84,48 @@ func LoadMetadata() (*ProjectMetadata, error) {
 	}
 	return &meta, nil
 }
+
+// RecordDependencies stores the direct requirements of the go.mod in path into its gocrafting-cli.json,
+// after go get and go mod tidy resolved them.
+func RecordDependencies(path string) error {
+	meta, err := ReadMetadata(path)
+	if err != nil {
+		return fmt.Errorf("failed to read metadata: %w", err)
+	}
+
+	goModPath := filepath.Join(path, "go.mod")
+	content, err := os.ReadFile(goModPath) // #nosec G304 -- path is the project being generated.
+	if err != nil {
+		return fmt.Errorf("failed to read go.mod: %w", err)
+	}
+
+	goMod, err := modfile.ParseLax(goModPath, content, nil)
+	if err != nil {
+		return fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
+	meta.Dependencies = map[string]string{}
+	for _, require := range goMod.Require {
+		if !require.Indirect {
+			meta.Dependencies[require.Mod.Path] = require.Mod.Version
+		}
+	}
+
+	return SaveMetadata(path, *meta)
+}
+
+// TestingFramework returns the testing style of the project, defaulting to the standard library.
+func (m ProjectMetadata) TestingFramework() string {
+	if m.SelectedTestingFramework == "" {
+		return TestingStdlib
+	}
+	return m.SelectedTestingFramework
+}
+
+// Logger returns the logger library of the project, defaulting to slog.
+func (m ProjectMetadata) Logger() string {
+	if m.SelectedLogger == "" {
+		return LoggerSlog
+	}
+	return m.SelectedLogger
+}
@@ -43,6 +43,75 @@ var AvailableAddons = []AddonOption{
 	},
 }
 
+// Testing styles of the generated tests.
+const (
+	TestingStdlib  = "Standard Library"
+	TestingTestify = "Testify"
+	TestingGinkgo  = "Ginkgo + Gomega"
+)
+
+// TestingFrameworks is the list of testing styles offered by the wizard, the default first.
+var TestingFrameworks = []string{TestingStdlib, TestingTestify, TestingGinkgo}
+
+// TestingPackages returns the packages required by generated tests in the given style.
+func TestingPackages(framework string) []string {
+	switch framework {
+	case TestingTestify:
+		return GetPackages("Testify")
+	case TestingGinkgo:
+		return append(GetPackages("Ginkgo"), GetPackages("Gomega")...)
+	default:
+		return []string{}
+	}
+}
+
+// Loggers backing the generated logger package.
+const (
+	LoggerSlog   = "slog (Standard Library)"
+	LoggerZap    = "Zap"
+	LoggerLogrus = "Logrus"
+)
+
+// Loggers is the list of loggers offered by the wizard, the default first.
+var Loggers = []string{LoggerSlog, LoggerZap, LoggerLogrus}
+
+// LoggerPackages returns the packages required by the generated logger package.
+func LoggerPackages(logger string) []string {
+	switch logger {
+	case LoggerZap:
+		return GetPackages("Zap")
+	case LoggerLogrus:
+		return GetPackages("Logrus")
+	default:
+		return []string{}
+	}
+}
+
+// wizardAddonLabels maps the labels stored by the wizard in SelectedAddons to add-on IDs.
+var wizardAddonLabels = map[string]string{
+	"Environment File (.env)":      "env",
+	"Gitignore File":               "gitignore",
+	"Readme File":                  "readme",
+	"Dockerfile":                   "docker",
+	"GitHub Actions (CI/CD)":       "github_action",
+	"Editor Config File":           "editorconfig",
+	"Makefile (Shortcut Commands)": "makefile",
+	"Lefthook (Commit Linter)":     "lefthook",
+}
+
+// AddonID returns the ID of an add-on given its ID or one of its labels, or "" when unknown.
+func AddonID(name string) string {
+	if id, ok := wizardAddonLabels[name]; ok {
+		return id
+	}
+	for _, addon := range AvailableAddons {
+		if addon.ID == name || addon.Label == name {
+			return addon.ID
+		}
+	}
+	return ""
+}
+
 // GetAddonLabelByID returns the label of an add-on given its ID.
 func GetAddonLabelByID(id string) string {
 	for _, addon := range AvailableAddons {
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/xRiot45/gocrafting/internal/dialect"
+
+	// Database drivers for every SQL option offered by the generators.
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+// Supported values of core.ProjectMetadata.SelectedDatabaseDriver.
+const (
+	DriverSQLite     = dialect.SQLite
+	DriverPostgreSQL = dialect.PostgreSQL
+	DriverMySQL      = dialect.MySQL
+)
+
+// DSN builds the database/sql driver name and DSN for the selected driver.
+// The defaults mirror the generated store.go so the CLI and the project always agree.
+func DSN(driver string, env *Env, projectName string) (string, string, error) {
+	name := env.GetDefault("DB_NAME", projectName)
+
+	switch driver {
+	case DriverSQLite:
+		return "sqlite", name + ".db", nil
+
+	case DriverPostgreSQL:
+		dsn := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(env.GetDefault("DB_USER", "postgres"), env.Get("DB_PASSWORD")),
+			Host:     net.JoinHostPort(env.GetDefault("DB_HOST", "127.0.0.1"), env.GetDefault("DB_PORT", "5432")),
+			Path:     "/" + name,
+			RawQuery: "sslmode=" + env.GetDefault("DB_SSL_MODE", "disable"),
+		}
+		return "postgres", dsn.String(), nil
+
+	case DriverMySQL:
+		// multiStatements allows a migration or seed file to contain several statements
+		address := net.JoinHostPort(env.GetDefault("DB_HOST", "127.0.0.1"), env.GetDefault("DB_PORT", "3306"))
+		dsn := fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true&multiStatements=true",
+			env.GetDefault("DB_USER", "root"),
+			env.Get("DB_PASSWORD"),
+			address,
+			name,
+		)
+		return "mysql", dsn, nil
+
+	case "", "None":
+		return "", "", fmt.Errorf("this project was generated without a database")
+
+	default:
+		return "", "", fmt.Errorf("database driver '%s' is not supported", driver)
+	}
+}
+
+// Open connects to the project's database and verifies the connection.
+func Open(driver string, env *Env, projectName string) (*sql.DB, error) {
+	driverName, dsn, err := DSN(driver, env, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return db, nil
+}
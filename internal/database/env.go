@@ -0,0 +1,105 @@
+// Package database connects the CLI to the database of a generated project.
+// It is used by the database management commands (migrate, seed).
+package database
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Env resolves configuration values the same way the generated project does:
+// process environment first, then the values loaded from env files.
+type Env struct {
+	values map[string]string
+}
+
+// LoadEnv reads the given env files in order. Later files override earlier ones.
+// Missing files are skipped so projects without the env add-on still work.
+func LoadEnv(paths ...string) (*Env, error) {
+	env := &Env{values: make(map[string]string)}
+
+	for _, path := range paths {
+		if err := env.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return env, nil
+}
+
+// Get returns the value for key, preferring the process environment.
+func (e *Env) Get(key string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return e.values[key]
+}
+
+// GetDefault returns the value for key or fallback when it is empty.
+func (e *Env) GetDefault(key, fallback string) string {
+	if value := e.Get(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// loadFile parses a single KEY=VALUE file.
+func (e *Env) loadFile(path string) error {
+	// #nosec G304 -- The env file path is chosen by the user running the CLI.
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to open env file %s: %w", path, err)
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, ok := parseEnvLine(scanner.Text())
+		if ok {
+			e.values[key] = value
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read env file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// parseEnvLine splits a dotenv line into key and value.
+// Comments, blank lines and trailing "# comment" parts are ignored.
+func parseEnvLine(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", "", false
+	}
+
+	line = strings.TrimPrefix(line, "export ")
+
+	key, value, found := strings.Cut(line, "=")
+	if !found {
+		return "", "", false
+	}
+
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return key, value[1 : len(value)-1], true
+	}
+
+	if idx := strings.Index(value, " #"); idx >= 0 {
+		value = strings.TrimSpace(value[:idx])
+	}
+
+	return key, value, true
+}
@@ -0,0 +1,271 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/dialect"
+)
+
+// MigrationsDir is the folder (relative to the project root) holding migration files.
+const MigrationsDir = "migrations"
+
+// migrationsTable records which migrations have been applied.
+const migrationsTable = "schema_migrations"
+
+// migrationFilePattern matches "<version>_<name>.<up|down>.sql".
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration is a pair of up/down SQL files sharing the same version.
+type Migration struct {
+	Version  int64
+	Name     string
+	UpPath   string
+	DownPath string
+}
+
+// LoadMigrations reads all migration files from dir, ordered by version.
+// A version used by two migration names, or by two files of the same direction, is an error.
+func LoadMigrations(dir string) ([]Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read migrations directory %s: %w", dir, err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+
+		// Two migrations generated in the same second would otherwise be merged silently
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("duplicate migration version %d: %d_%s and %s", version, version, migration.Name, entry.Name())
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		target := &migration.UpPath
+		if match[3] == "down" {
+			target = &migration.DownPath
+		}
+		if *target != "" {
+			return nil, fmt.Errorf("duplicate migration file for version %d: %s and %s", version, filepath.Base(*target), entry.Name())
+		}
+		*target = path
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.UpPath == "" {
+			return nil, fmt.Errorf("migration %d_%s is missing its .up.sql file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Migrator applies and rolls back migrations, tracking them in a version table.
+type Migrator struct {
+	db     *sql.DB
+	driver string
+	dir    string
+}
+
+// NewMigrator creates a Migrator for the given connection.
+// The driver is the project's SelectedDatabaseDriver and decides the placeholder style.
+func NewMigrator(db *sql.DB, driver, dir string) *Migrator {
+	return &Migrator{db: db, driver: driver, dir: dir}
+}
+
+// Up applies pending migrations in order. A steps value <= 0 applies all of them.
+func (m *Migrator) Up(ctx context.Context, steps int) ([]Migration, error) {
+	migrations, applied, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range migrations {
+		if applied[migration.Version] {
+			continue
+		}
+		if steps > 0 && len(done) == steps {
+			break
+		}
+
+		insert := fmt.Sprintf("INSERT INTO %s (version, name) VALUES (%s, %s)",
+			migrationsTable, dialect.Placeholder(m.driver, 1), dialect.Placeholder(m.driver, 2))
+
+		if err := m.execFile(ctx, migration.UpPath, insert, migration.Version, migration.Name); err != nil {
+			return done, fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Down rolls back the most recently applied migrations. A steps value <= 0 rolls back one.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		steps = 1
+	}
+
+	migrations, applied, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		migration := migrations[i]
+		if !applied[migration.Version] {
+			continue
+		}
+		if migration.DownPath == "" {
+			return done, fmt.Errorf("migration %d_%s has no .down.sql file", migration.Version, migration.Name)
+		}
+
+		remove := fmt.Sprintf("DELETE FROM %s WHERE version = %s", migrationsTable, dialect.Placeholder(m.driver, 1))
+
+		if err := m.execFile(ctx, migration.DownPath, remove, migration.Version); err != nil {
+			return done, fmt.Errorf("rollback of %d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Version returns the highest applied migration version, or 0 when none are applied.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return 0, err
+	}
+
+	var version sql.NullInt64
+	query := fmt.Sprintf("SELECT MAX(version) FROM %s", migrationsTable)
+	if err := m.db.QueryRowContext(ctx, query).Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read migration version: %w", err)
+	}
+
+	return version.Int64, nil
+}
+
+// load returns the migrations on disk and the set of applied versions.
+func (m *Migrator) load(ctx context.Context) ([]Migration, map[int64]bool, error) {
+	migrations, err := LoadMigrations(m.dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := m.db.QueryContext(ctx, fmt.Sprintf("SELECT version FROM %s", migrationsTable))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	applied := make(map[int64]bool)
+	for rows.Next() {
+		var version int64
+		if err := rows.Scan(&version); err != nil {
+			return nil, nil, fmt.Errorf("failed to read applied migrations: %w", err)
+		}
+		applied[version] = true
+	}
+
+	return migrations, applied, rows.Err()
+}
+
+// ensureTable creates the version table when it does not exist yet.
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	query := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	version BIGINT NOT NULL PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`, migrationsTable)
+
+	if _, err := m.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create %s table: %w", migrationsTable, err)
+	}
+	return nil
+}
+
+// execFile runs a SQL file and the bookkeeping statement in one transaction.
+func (m *Migrator) execFile(ctx context.Context, path, bookkeeping string, args ...any) error {
+	// #nosec G304 -- Migration files are discovered inside the project's migrations directory.
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if hasStatements(string(content)) {
+		if _, err := tx.ExecContext(ctx, string(content)); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, bookkeeping, args...); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to update %s: %w", migrationsTable, err)
+	}
+
+	return tx.Commit()
+}
+
+// hasStatements reports whether the SQL contains anything besides comments and blank lines.
+// Some drivers (MySQL) reject empty queries, e.g. a freshly generated migration.
+func hasStatements(content string) bool {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+	return false
+}
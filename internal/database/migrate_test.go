@@ -0,0 +1,175 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// openTestDB opens an empty SQLite database inside a temp dir.
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+// writeFiles writes name -> content pairs into a new temp dir and returns it.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// tableExists reports whether table exists in the SQLite database.
+func tableExists(t *testing.T, db *sql.DB, table string) bool {
+	t.Helper()
+
+	var count int
+	err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return count > 0
+}
+
+func TestMigratorUpDownVersion(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	dir := writeFiles(t, map[string]string{
+		"20240101000000_create_users.up.sql":   "CREATE TABLE users (id INTEGER PRIMARY KEY);",
+		"20240101000000_create_users.down.sql": "DROP TABLE users;",
+		"20240102000000_create_posts.up.sql":   "CREATE TABLE posts (id INTEGER PRIMARY KEY);",
+		"20240102000000_create_posts.down.sql": "DROP TABLE posts;",
+	})
+	migrator := NewMigrator(db, DriverSQLite, dir)
+
+	version, err := migrator.Version(ctx)
+	if err != nil || version != 0 {
+		t.Fatalf("Version() = %d, %v; want 0", version, err)
+	}
+
+	done, err := migrator.Up(ctx, 1)
+	if err != nil || len(done) != 1 || done[0].Name != "create_users" {
+		t.Fatalf("Up(1) = %v, %v; want create_users", done, err)
+	}
+
+	done, err = migrator.Up(ctx, 0)
+	if err != nil || len(done) != 1 || done[0].Name != "create_posts" {
+		t.Fatalf("Up(0) = %v, %v; want create_posts", done, err)
+	}
+	if version, _ := migrator.Version(ctx); version != 20240102000000 {
+		t.Errorf("Version() after Up = %d, want 20240102000000", version)
+	}
+	if !tableExists(t, db, "users") || !tableExists(t, db, "posts") {
+		t.Error("Up did not create both tables")
+	}
+
+	done, err = migrator.Down(ctx, 0)
+	if err != nil || len(done) != 1 || done[0].Name != "create_posts" {
+		t.Fatalf("Down(0) = %v, %v; want create_posts", done, err)
+	}
+	if tableExists(t, db, "posts") {
+		t.Error("Down did not drop posts")
+	}
+	if version, _ := migrator.Version(ctx); version != 20240101000000 {
+		t.Errorf("Version() after Down = %d, want 20240101000000", version)
+	}
+}
+
+func TestMigratorUpRollsBackFailedMigration(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	dir := writeFiles(t, map[string]string{
+		"20240101000000_create_users.up.sql":   "CREATE TABLE users (id INTEGER PRIMARY KEY);",
+		"20240102000000_broken.up.sql":         "CREATE TABLE posts (id INTEGER PRIMARY KEY);\nINSERT INTO missing (id) VALUES (1);",
+		"20240103000000_create_tags.up.sql":    "CREATE TABLE tags (id INTEGER PRIMARY KEY);",
+		"20240101000000_create_users.down.sql": "DROP TABLE users;",
+	})
+	migrator := NewMigrator(db, DriverSQLite, dir)
+
+	done, err := migrator.Up(ctx, 0)
+	if err == nil || !strings.Contains(err.Error(), "20240102000000_broken") {
+		t.Fatalf("Up() error = %v, want the broken migration named", err)
+	}
+	if len(done) != 1 || done[0].Name != "create_users" {
+		t.Errorf("Up() applied %v, want only create_users", done)
+	}
+
+	if tableExists(t, db, "posts") {
+		t.Error("the statement before the failing one was not rolled back")
+	}
+	if tableExists(t, db, "tags") {
+		t.Error("migrations after the failing one were applied")
+	}
+	if version, _ := migrator.Version(ctx); version != 20240101000000 {
+		t.Errorf("Version() = %d, want 20240101000000", version)
+	}
+}
+
+func TestLoadMigrationsDuplicateVersion(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{
+			name: "different names",
+			files: map[string]string{
+				"20240101000000_create_users.up.sql": "",
+				"20240101000000_create_posts.up.sql": "",
+			},
+			want: "duplicate migration version 20240101000000",
+		},
+		{
+			name: "same direction",
+			files: map[string]string{
+				"20240101000000_create_users.up.sql":  "",
+				"020240101000000_create_users.up.sql": "",
+			},
+			want: "duplicate migration file for version 20240101000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadMigrations(writeFiles(t, tt.files))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadMigrations() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadMigrationsPairsDirections(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"20240102000000_create_posts.up.sql":   "",
+		"20240101000000_create_users.up.sql":   "",
+		"20240101000000_create_users.down.sql": "",
+		"README.md":                            "",
+	})
+
+	migrations, err := LoadMigrations(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 2 || migrations[0].Version != 20240101000000 || migrations[1].Version != 20240102000000 {
+		t.Fatalf("LoadMigrations() = %v, want two migrations in version order", migrations)
+	}
+	if migrations[0].UpPath == "" || migrations[0].DownPath == "" || migrations[1].DownPath != "" {
+		t.Errorf("LoadMigrations() paths = %+v", migrations)
+	}
+}
@@ -0,0 +1,316 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/xRiot45/gocrafting/internal/dialect"
+)
+
+// SeedsDir is the folder (relative to the project root) holding seed files.
+const SeedsDir = "seeds"
+
+// seedsTable records which seed files have already been loaded.
+const seedsTable = "schema_seeds"
+
+// identifierPattern guards table and column names taken from fixture files.
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// Seed is a single file in the seeds directory.
+// SQL files are executed as-is, YAML and JSON files are loaded as fixtures.
+type Seed struct {
+	Name string
+	Path string
+}
+
+// IsFixture reports whether the seed is a YAML/JSON fixture rather than raw SQL.
+func (s Seed) IsFixture() bool {
+	switch strings.ToLower(filepath.Ext(s.Name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// Fixture inserts rows into a single table. A fixture file is a list of them, e.g.
+// `[{"table": "users", "rows": [{"name": "Alice"}]}]` or the equivalent YAML.
+type Fixture struct {
+	Table string           `yaml:"table"`
+	Rows  []map[string]any `yaml:"rows"`
+}
+
+// LoadSeeds reads all seed files from dir, ordered by file name.
+func LoadSeeds(dir string) ([]Seed, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read seeds directory %s: %w", dir, err)
+	}
+
+	var seeds []Seed
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		seed := Seed{Name: entry.Name(), Path: filepath.Join(dir, entry.Name())}
+		if seed.IsFixture() || strings.EqualFold(filepath.Ext(seed.Name), ".sql") {
+			seeds = append(seeds, seed)
+		}
+	}
+
+	sort.Slice(seeds, func(i, j int) bool {
+		return seeds[i].Name < seeds[j].Name
+	})
+
+	return seeds, nil
+}
+
+// LoadFixtures parses a YAML or JSON fixture file (JSON is valid YAML).
+func LoadFixtures(path string) ([]Fixture, error) {
+	// #nosec G304 -- Fixture files are discovered inside the project's seeds directory.
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var fixtures []Fixture
+	if err := yaml.Unmarshal(content, &fixtures); err != nil {
+		return nil, fmt.Errorf("invalid fixture file %s: %w", path, err)
+	}
+
+	for _, fixture := range fixtures {
+		if !identifierPattern.MatchString(fixture.Table) {
+			return nil, fmt.Errorf("invalid table name '%s' in %s", fixture.Table, path)
+		}
+	}
+
+	return fixtures, nil
+}
+
+// Seeder loads seed files into the database exactly once per file.
+type Seeder struct {
+	db     *sql.DB
+	driver string
+	dir    string
+}
+
+// NewSeeder creates a Seeder for the given connection.
+// The driver is the project's SelectedDatabaseDriver and decides the placeholder style.
+func NewSeeder(db *sql.DB, driver, dir string) *Seeder {
+	return &Seeder{db: db, driver: driver, dir: dir}
+}
+
+// Run loads every seed that has not been recorded yet, in file-name order.
+func (s *Seeder) Run(ctx context.Context) ([]Seed, error) {
+	seeds, err := LoadSeeds(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := s.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Seed
+	for _, seed := range seeds {
+		if applied[seed.Name] {
+			continue
+		}
+
+		if err := s.apply(ctx, seed); err != nil {
+			return done, fmt.Errorf("seed %s failed: %w", seed.Name, err)
+		}
+		done = append(done, seed)
+	}
+
+	return done, nil
+}
+
+// Reset empties the tables filled by fixtures and forgets the fixture seeds, so the next Run loads them again.
+// SQL seeds stay recorded: their data is left untouched, and running them again would insert it twice.
+func (s *Seeder) Reset(ctx context.Context) error {
+	seeds, err := LoadSeeds(s.dir)
+	if err != nil {
+		return err
+	}
+
+	var tables, names []string
+	for _, seed := range seeds {
+		if !seed.IsFixture() {
+			continue
+		}
+		names = append(names, seed.Name)
+
+		fixtures, err := LoadFixtures(seed.Path)
+		if err != nil {
+			return err
+		}
+		for _, fixture := range fixtures {
+			tables = append(tables, fixture.Table)
+		}
+	}
+
+	if err := s.ensureTable(ctx); err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Delete in reverse order so rows referencing earlier fixtures go first
+	cleared := make(map[string]bool)
+	for i := len(tables) - 1; i >= 0; i-- {
+		if cleared[tables[i]] {
+			continue
+		}
+		cleared[tables[i]] = true
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+tables[i]); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to clear table %s: %w", tables[i], err)
+		}
+	}
+
+	forget := fmt.Sprintf("DELETE FROM %s WHERE name = %s", seedsTable, dialect.Placeholder(s.driver, 1))
+	for _, name := range names {
+		if _, err := tx.ExecContext(ctx, forget, name); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to update %s: %w", seedsTable, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// apply loads a single seed and records it in one transaction.
+func (s *Seeder) apply(ctx context.Context, seed Seed) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if seed.IsFixture() {
+		err = s.insertFixtures(ctx, tx, seed.Path)
+	} else {
+		err = s.execSQL(ctx, tx, seed.Path)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	record := fmt.Sprintf("INSERT INTO %s (name) VALUES (%s)", seedsTable, dialect.Placeholder(s.driver, 1))
+	if _, err := tx.ExecContext(ctx, record, seed.Name); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to update %s: %w", seedsTable, err)
+	}
+
+	return tx.Commit()
+}
+
+// execSQL runs a raw SQL seed file.
+func (s *Seeder) execSQL(ctx context.Context, tx *sql.Tx, path string) error {
+	// #nosec G304 -- Seed files are discovered inside the project's seeds directory.
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if !hasStatements(string(content)) {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, string(content))
+	return err
+}
+
+// insertFixtures inserts every row of a fixture file using parameterized statements.
+func (s *Seeder) insertFixtures(ctx context.Context, tx *sql.Tx, path string) error {
+	fixtures, err := LoadFixtures(path)
+	if err != nil {
+		return err
+	}
+
+	for _, fixture := range fixtures {
+		for _, row := range fixture.Rows {
+			columns := make([]string, 0, len(row))
+			for column := range row {
+				if !identifierPattern.MatchString(column) {
+					return fmt.Errorf("invalid column name '%s' for table %s", column, fixture.Table)
+				}
+				columns = append(columns, column)
+			}
+			sort.Strings(columns)
+
+			placeholders := make([]string, len(columns))
+			args := make([]any, len(columns))
+			for i, column := range columns {
+				placeholders[i] = dialect.Placeholder(s.driver, i+1)
+				args[i] = row[column]
+			}
+
+			query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+				fixture.Table, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return fmt.Errorf("failed to insert into %s: %w", fixture.Table, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applied returns the set of seed files that have already been loaded.
+func (s *Seeder) applied(ctx context.Context) (map[string]bool, error) {
+	if err := s.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf("SELECT name FROM %s", seedsTable))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied seeds: %w", err)
+	}
+
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	applied := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to read applied seeds: %w", err)
+		}
+		applied[name] = true
+	}
+
+	return applied, rows.Err()
+}
+
+// ensureTable creates the seed record table when it does not exist yet.
+func (s *Seeder) ensureTable(ctx context.Context) error {
+	query := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	name VARCHAR(255) NOT NULL PRIMARY KEY,
+	applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`, seedsTable)
+
+	if _, err := s.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create %s table: %w", seedsTable, err)
+	}
+	return nil
+}
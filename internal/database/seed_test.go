@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+// countRows returns the number of rows in table.
+func countRows(t *testing.T, db *sql.DB, table string) int {
+	t.Helper()
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+// newSeedTest creates the tables used by the seeds and returns a Seeder over them.
+func newSeedTest(t *testing.T) (*sql.DB, *Seeder) {
+	t.Helper()
+
+	db := openTestDB(t)
+	if _, err := db.Exec("CREATE TABLE roles (id INTEGER PRIMARY KEY, name TEXT NOT NULL UNIQUE);" +
+		"CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT NOT NULL);"); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := writeFiles(t, map[string]string{
+		"001_roles.sql":  "INSERT INTO roles (name) VALUES ('admin'), ('member');",
+		"002_users.yaml": "- table: users\n  rows:\n    - name: Alice\n    - name: Bob\n",
+		"003_empty.sql":  "-- nothing to seed yet\n",
+		"notes.txt":      "ignored",
+		"004_extra.json": `[{"table": "users", "rows": [{"name": "Carol"}]}]`,
+	})
+	return db, NewSeeder(db, DriverSQLite, dir)
+}
+
+func TestSeederRunIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	db, seeder := newSeedTest(t)
+
+	seeded, err := seeder.Run(ctx)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(seeded) != 4 {
+		t.Fatalf("Run() seeded %d files, want 4", len(seeded))
+	}
+
+	seeded, err = seeder.Run(ctx)
+	if err != nil || len(seeded) != 0 {
+		t.Fatalf("second Run() = %v, %v; want nothing seeded", seeded, err)
+	}
+	if roles, users := countRows(t, db, "roles"), countRows(t, db, "users"); roles != 2 || users != 3 {
+		t.Errorf("rows after two runs: roles = %d, users = %d; want 2 and 3", roles, users)
+	}
+}
+
+func TestSeederResetReloadsOnlyFixtures(t *testing.T) {
+	ctx := context.Background()
+	db, seeder := newSeedTest(t)
+
+	if _, err := seeder.Run(ctx); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for range 2 {
+		if err := seeder.Reset(ctx); err != nil {
+			t.Fatalf("Reset() error = %v", err)
+		}
+		if users := countRows(t, db, "users"); users != 0 {
+			t.Errorf("users after Reset() = %d, want 0", users)
+		}
+
+		// The SQL seed would fail on the UNIQUE constraint if it ran again
+		seeded, err := seeder.Run(ctx)
+		if err != nil {
+			t.Fatalf("Run() after Reset() error = %v", err)
+		}
+		if len(seeded) != 2 || seeded[0].Name != "002_users.yaml" || seeded[1].Name != "004_extra.json" {
+			t.Errorf("Run() after Reset() seeded %v, want the two fixtures", seeded)
+		}
+		if roles, users := countRows(t, db, "roles"), countRows(t, db, "users"); roles != 2 || users != 3 {
+			t.Errorf("rows after Reset() and Run(): roles = %d, users = %d; want 2 and 3", roles, users)
+		}
+	}
+}
+
+func TestSeederRunRollsBackFailedSeed(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	if _, err := db.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+
+	seeder := NewSeeder(db, DriverSQLite, writeFiles(t, map[string]string{
+		"001_users.yaml": "- table: users\n  rows:\n    - name: Alice\n    - email: bob@example.com\n",
+	}))
+
+	if _, err := seeder.Run(ctx); err == nil {
+		t.Fatal("Run() error = nil, want the failing fixture")
+	}
+	if users := countRows(t, db, "users"); users != 0 {
+		t.Errorf("users after failed seed = %d, want 0", users)
+	}
+	if seeds := countRows(t, db, seedsTable); seeds != 0 {
+		t.Errorf("%s rows after failed seed = %d, want 0", seedsTable, seeds)
+	}
+}
@@ -0,0 +1,31 @@
+// Package dialect holds the SQL syntax that differs between the supported database drivers.
+// It has no dependencies, so the template engine can use it without linking the drivers themselves.
+package dialect
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Supported values of core.ProjectMetadata.SelectedDatabaseDriver.
+const (
+	SQLite     = "SQLite"
+	PostgreSQL = "PostgreSQL"
+	MySQL      = "MySQL"
+)
+
+// Placeholder returns the bind parameter for the n-th (1-based) argument of a query.
+func Placeholder(driver string, n int) string {
+	if driver == PostgreSQL {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// Quote returns name as a quoted identifier, so columns named after keywords such as "order" stay valid.
+func Quote(driver, name string) string {
+	if driver == MySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -1,13 +1,11 @@
 package common
 
 import (
-	"bytes"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
-	"text/template"
 	"time"
 
 	"github.com/xRiot45/gocrafting/internal/core"
@@ -27,61 +25,51 @@ func BaseGenerate(config core.ProjectConfig, templateSourcePath string) error {
 		return fmt.Errorf("failed to create metadata file: %w", err)
 	}
 
-	// 3. Copy project template files
+	// 3. Render project template files
 	// Note: templateSourcePath is sent from the caller (eg: "small/simple-api")
-	if err := copyResources(templateSourcePath, config); err != nil {
+	if err := templates.NewEngine(config.ProjectName, templates.FS).RenderDir(templateSourcePath, ".", config); err != nil {
 		return err
 	}
 
+	// 4. Generate the logger package used by main, handlers and middleware
+	if err := GenerateLogger(config); err != nil {
+		return fmt.Errorf("failed to generate logger package: %w", err)
+	}
+
 	return nil
 }
 
-// copyResources scans internal/templates and copies them to the destination.
-func copyResources(sourceDir string, config core.ProjectConfig) error {
-	fileSystem := templates.FS
+// BaseGenerateFiles is BaseGenerate for an explicit list of template files, e.g. the files of a template pack.
+// File paths are kept relative to the project root and ".tmpl" files are rendered without the suffix.
+func BaseGenerateFiles(config core.ProjectConfig, fileSystem fs.FS, files []string) error {
+	if err := os.MkdirAll(config.ProjectName, 0750); err != nil {
+		return fmt.Errorf("failed to create project folder: %w", err)
+	}
 
-	return fs.WalkDir(fileSystem, sourceDir, func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
+	if err := createMetaFile(config); err != nil {
+		return fmt.Errorf("failed to create metadata file: %w", err)
+	}
 
-		relPath, err := filepath.Rel(sourceDir, path)
-		if err != nil {
+	engine := templates.NewEngine(config.ProjectName, fileSystem)
+	for _, file := range files {
+		if err := engine.RenderFile(file, filepath.FromSlash(strings.TrimSuffix(file, ".tmpl")), config); err != nil {
 			return err
 		}
+	}
 
-		if relPath == "." {
-			return nil
-		}
-
-		targetPath := filepath.Join(config.ProjectName, strings.TrimSuffix(relPath, ".tmpl"))
-
-		if d.IsDir() {
-			return os.MkdirAll(targetPath, 0750)
-		}
-
-		return forgeFile(fileSystem, path, targetPath, config)
-	})
+	return nil
 }
 
-// forgeFile reads, processes, and writes a single file to disk.
-func forgeFile(fileSystem fs.FS, sourcePath, targetPath string, config core.ProjectConfig) error {
-	content, err := fs.ReadFile(fileSystem, sourcePath)
-	if err != nil {
-		return fmt.Errorf("failed to read template file %s: %w", sourcePath, err)
-	}
-
-	var processedContent []byte
-	if strings.HasSuffix(sourcePath, ".tmpl") {
-		processedContent, err = processTemplate(sourcePath, content, config)
-		if err != nil {
-			return err
-		}
-	} else {
-		processedContent = content
+// GenerateLogger renders the logger package of the selected logger (slog, Zap or Logrus) into the project.
+// Small projects keep it at the root ("logger/"), larger scales under "internal/logger/".
+func GenerateLogger(config core.ProjectConfig) error {
+	loggerDir := "logger"
+	if config.ProjectScale != "Small" {
+		loggerDir = filepath.Join("internal", "logger")
 	}
 
-	return os.WriteFile(targetPath, processedContent, 0600)
+	engine := templates.NewEngine(config.ProjectName, templates.FS)
+	return engine.RenderFile("common/logger/logger.go.tmpl", filepath.Join(loggerDir, "logger.go"), config)
 }
 
 // createMetaFile creates the project metadata file using struct from core.
@@ -93,16 +81,20 @@ func createMetaFile(config core.ProjectConfig) error {
 	// }
 
 	// 2. ISI STRUCT META
+	withTests := true
 	meta := core.ProjectMetadata{
-		CLIVersion:             "v1.0.0",
-		ProjectName:            config.ProjectName,
-		ModuleName:             config.ModuleName,
-		ProjectScale:           config.ProjectScale,
-		SelectedTemplate:       config.SelectedTemplate,
-		SelectedFramework:      config.SelectedFramework,
-		SelectedDatabaseDriver: config.SelectedDatabaseDriver,
-		SelectedAddons:         config.SelectedAddons,
-		CreatedAt:              time.Now(),
+		CLIVersion:               "v1.0.0",
+		ProjectName:              config.ProjectName,
+		ModuleName:               config.ModuleName,
+		ProjectScale:             config.ProjectScale,
+		SelectedTemplate:         config.SelectedTemplate,
+		SelectedFramework:        config.SelectedFramework,
+		SelectedDatabaseDriver:   config.SelectedDatabaseDriver,
+		SelectedTestingFramework: config.SelectedTestingFramework,
+		SelectedLogger:           config.SelectedLogger,
+		SelectedAddons:           config.SelectedAddons,
+		WithTests:                &withTests,
+		CreatedAt:                time.Now(),
 	}
 
 	// 3. WRITE FILE
@@ -112,18 +104,3 @@ func createMetaFile(config core.ProjectConfig) error {
 
 	return nil
 }
-
-// processTemplate processes the raw template data using the Go text/template engine.
-func processTemplate(name string, content []byte, config core.ProjectConfig) ([]byte, error) {
-	tmpl, err := template.New(name).Parse(string(content))
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, config); err != nil {
-		return nil, fmt.Errorf("failed to execute template %s: %w", name, err)
-	}
-
-	return buf.Bytes(), nil
-}
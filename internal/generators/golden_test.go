@@ -0,0 +1,305 @@
+package generators_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/generators"
+	"github.com/xRiot45/gocrafting/internal/shell"
+	"github.com/xRiot45/gocrafting/internal/templates"
+)
+
+// update rewrites the golden directories: go test ./internal/generators -run TestGolden -update
+var update = flag.Bool("update", false, "rewrite the golden files in testdata/golden")
+
+const (
+	// goldenDir holds one directory per combination, every file stored with the goldenSuffix
+	// so generated .gitignore and go.mod files do not affect the repository.
+	goldenDir    = "testdata/golden"
+	goldenSuffix = ".golden"
+	// commandsFile records the commands the generator ran, e.g. the packages passed to go get.
+	commandsFile = "commands.txt"
+)
+
+// createdAtPattern matches the generation time recorded in gocrafting-cli.json.
+var createdAtPattern = regexp.MustCompile(`"created_at": "[^"]*"`)
+
+// fakeExecutor records commands instead of running them, so generation needs no network.
+type fakeExecutor struct {
+	commands []string
+}
+
+func (f *fakeExecutor) Run(dir, name string, args ...string) ([]byte, error) {
+	f.commands = append(f.commands, fmt.Sprintf("[%s] %s %s", filepath.ToSlash(dir), name, strings.Join(args, " ")))
+	return nil, nil
+}
+
+// goldenCase is one generated project.
+type goldenCase struct {
+	name   string
+	config core.ProjectConfig
+}
+
+// goldenCases returns every template/framework/database combination of every provider, without add-ons
+// and with all of them, plus each testing style and logger on the first combination of every template,
+// and each add-on alone on the first combination with a database, so add-ons cannot mask each other.
+func goldenCases(t *testing.T) []goldenCase {
+	t.Helper()
+
+	var allAddons []string
+	for _, addon := range core.AvailableAddons {
+		allAddons = append(allAddons, addon.ID)
+	}
+
+	var cases []goldenCase
+	add := func(config core.ProjectConfig, suffix ...string) {
+		parts := append([]string{config.ProjectScale, config.SelectedTemplate, config.SelectedFramework, config.SelectedDatabaseDriver}, suffix...)
+		name := strings.ToLower(strings.NewReplacer(" ", "-", "(", "", ")", "", "+", "").Replace(strings.Join(parts, "_")))
+		cases = append(cases, goldenCase{name: strings.ReplaceAll(name, "--", "-"), config: config})
+	}
+
+	for _, scale := range []string{"Small", "Medium", "Enterprise"} {
+		provider, err := generators.GetProvider(scale)
+		if err != nil {
+			continue
+		}
+
+		for _, template := range provider.GetTemplates() {
+			dir := provider.GetTemplateDir(template)
+			if _, err := fs.Stat(templates.FS, dir); dir == "" || err != nil {
+				t.Logf("skipping %s / %s: no template files", scale, template)
+				continue
+			}
+
+			first, firstWithDatabase := true, true
+			for _, framework := range orNone(provider.GetFrameworks(template)) {
+				for _, driver := range orNone(provider.GetDatabaseDrivers(template)) {
+					config := core.ProjectConfig{
+						ProjectName:            "app",
+						ModuleName:             "example.com/app",
+						ProjectScale:           scale,
+						SelectedTemplate:       template,
+						SelectedFramework:      framework,
+						SelectedDatabaseDriver: driver,
+					}
+					add(config, "no-addons")
+
+					config.SelectedAddons = allAddons
+					add(config, "all-addons")
+
+					if firstWithDatabase && driver != "None" {
+						firstWithDatabase = false
+						for _, addon := range allAddons {
+							variant := config
+							variant.SelectedAddons = []string{addon}
+							add(variant, addon)
+						}
+					}
+
+					if first {
+						first = false
+						for _, testing := range core.TestingFrameworks[1:] {
+							variant := config
+							variant.SelectedTestingFramework = testing
+							add(variant, "all-addons", testing)
+						}
+						for _, logger := range core.Loggers[1:] {
+							variant := config
+							variant.SelectedLogger = logger
+							add(variant, "all-addons", logger)
+						}
+					}
+				}
+			}
+		}
+	}
+	return cases
+}
+
+// orNone returns options, or "None" when the wizard skips the step because there are none.
+func orNone(options []string) []string {
+	if len(options) == 0 {
+		return []string{"None"}
+	}
+	return options
+}
+
+// TestGolden generates every combination into a temp dir and compares the tree with testdata/golden.
+func TestGolden(t *testing.T) {
+	goldenRoot, err := filepath.Abs(goldenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Keep user-level template overrides and packs out of the snapshots
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cases := goldenCases(t)
+	if len(cases) == 0 {
+		t.Fatal("no combinations to generate")
+	}
+
+	if *update {
+		if err := os.RemoveAll(goldenRoot); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			executor := &fakeExecutor{}
+			t.Cleanup(shell.SetExecutor(executor))
+			t.Chdir(t.TempDir())
+
+			provider, err := generators.GetProvider(tc.config.ProjectScale)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := provider.Generate(tc.config); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			got, err := readTree(tc.config.ProjectName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got[commandsFile] = []byte(strings.Join(executor.commands, "\n") + "\n")
+
+			dir := filepath.Join(goldenRoot, tc.name)
+			if *update {
+				if err := writeGolden(dir, got); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := readGolden(dir)
+			if err != nil {
+				t.Fatalf("golden files missing, run 'go test ./internal/generators -run TestGolden -update': %v", err)
+			}
+			compareTrees(t, got, want)
+		})
+	}
+}
+
+// readTree reads every file below root, keyed by slash-separated relative path.
+func readTree(root string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(path) // #nosec G304 -- path is inside the generated test project.
+		if err != nil {
+			return err
+		}
+		if rel == "gocrafting-cli.json" {
+			content = createdAtPattern.ReplaceAll(content, []byte(`"created_at": "<created_at>"`))
+		}
+
+		files[filepath.ToSlash(rel)] = content
+		return nil
+	})
+	return files, err
+}
+
+// readGolden reads a golden directory, stripping the golden suffix from every file.
+func readGolden(dir string) (map[string][]byte, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+
+	stored, err := readTree(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	for name, content := range stored {
+		files[strings.TrimSuffix(name, goldenSuffix)] = content
+	}
+	return files, nil
+}
+
+// writeGolden writes files into dir with the golden suffix.
+func writeGolden(dir string, files map[string][]byte) error {
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name)+goldenSuffix)
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, content, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compareTrees reports files missing from, added to, or differing from the golden tree.
+func compareTrees(t *testing.T, got, want map[string][]byte) {
+	t.Helper()
+
+	names := map[string]bool{}
+	for name := range got {
+		names[name] = true
+	}
+	for name := range want {
+		names[name] = true
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		gotContent, inGot := got[name]
+		wantContent, inWant := want[name]
+
+		switch {
+		case !inGot:
+			t.Errorf("%s: expected by the golden files but not generated", name)
+		case !inWant:
+			t.Errorf("%s: generated but missing from the golden files", name)
+		case !bytes.Equal(gotContent, wantContent):
+			line, gotLine, wantLine := firstDifference(gotContent, wantContent)
+			t.Errorf("%s:%d differs from the golden file\n   got: %q\n  want: %q", name, line, gotLine, wantLine)
+		}
+	}
+}
+
+// firstDifference returns the first differing line (1-based) of two contents and its text on each side.
+func firstDifference(got, want []byte) (int, string, string) {
+	gotLines := strings.Split(string(got), "\n")
+	wantLines := strings.Split(string(want), "\n")
+
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		var gotLine, wantLine string
+		if i < len(gotLines) {
+			gotLine = gotLines[i]
+		}
+		if i < len(wantLines) {
+			wantLine = wantLines[i]
+		}
+		if gotLine != wantLine || i >= len(gotLines) || i >= len(wantLines) {
+			return i + 1, gotLine, wantLine
+		}
+	}
+	return 0, "", ""
+}
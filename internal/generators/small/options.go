@@ -1,14 +1,47 @@
 // Package small implements the logic and configuration for "Small" scale projects.
 package small
 
-// GetTemplates returns available templates for Small scale
+import (
+	"slices"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/packs"
+)
+
+// builtinTemplates are the templates shipped with the CLI for Small scale.
+var builtinTemplates = []string{
+	"Simple API",
+	"Fast HTTP",
+	"CLI Tool",
+	"Telegram Bot Starter",
+}
+
+// GetTemplates returns available templates for Small scale, followed by the installed template packs
 func GetTemplates() []string {
-	return []string{
-		"Simple API",
-		"Fast HTTP",
-		"CLI Tool",
-		"Telegram Bot Starter",
+	templates := slices.Clone(builtinTemplates)
+	for _, pack := range packs.ForScale("Small") {
+		if !slices.Contains(templates, pack.Template) {
+			templates = append(templates, pack.Template)
+		}
 	}
+	return templates
+}
+
+// findPack returns the template pack providing template; built-in templates always take precedence.
+func findPack(template string) (*packs.Pack, bool) {
+	if slices.Contains(builtinTemplates, template) {
+		return nil, false
+	}
+	return packs.Find("Small", template)
+}
+
+// GetTemplateDir returns the folder of a built-in template in the embedded templates, e.g. "small/simple-api".
+// Template packs have no folder there, so it returns "" for them.
+func GetTemplateDir(template string) string {
+	if !slices.Contains(builtinTemplates, template) {
+		return ""
+	}
+	return "small/" + strings.ReplaceAll(strings.ToLower(template), " ", "-")
 }
 
 // GetFrameworks returns available frameworks based on the selected template
@@ -27,12 +60,19 @@ func GetFrameworks(template string) []string {
 	case "Telegram Bot Starter":
 		return []string{}
 	default:
+		if pack, ok := findPack(template); ok {
+			return pack.Frameworks
+		}
 		return []string{}
 	}
 }
 
 // GetDatabaseDrivers returns available database options
 func GetDatabaseDrivers(template string) []string {
+	if pack, ok := findPack(template); ok {
+		return pack.DatabaseDrivers
+	}
+
 	if template == "CLI Tool" || template == "Telegram Bot Starter" {
 		return []string{
 			"None",
@@ -26,6 +26,11 @@ func (p Provider) GetDatabaseDrivers(template string) []string {
 	return GetDatabaseDrivers(template)
 }
 
+// GetTemplateDir (Implementasi Interface)
+func (p Provider) GetTemplateDir(template string) string {
+	return GetTemplateDir(template)
+}
+
 // Generate (Implementasi Interface)
 func (p Provider) Generate(config core.ProjectConfig) error {
 	return Generate(config)
@@ -2,10 +2,10 @@ package small
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/xRiot45/gocrafting/internal/core"
 	common "github.com/xRiot45/gocrafting/internal/generators/common"
+	"github.com/xRiot45/gocrafting/internal/packs"
 	"github.com/xRiot45/gocrafting/internal/shell"
 )
 
@@ -17,10 +17,16 @@ import (
 //
 // Returns an error if there is an issue during the generation process.
 func Generate(config core.ProjectConfig) error {
-	normalizedName := strings.ToLower(config.SelectedTemplate)
-	normalizedName = strings.ReplaceAll(normalizedName, " ", "-")
+	// Template packs installed by the user bring their own files and dependencies
+	if pack, ok := findPack(config.SelectedTemplate); ok {
+		return generatePack(config, *pack)
+	}
+
+	templatePath := GetTemplateDir(config.SelectedTemplate)
+	if templatePath == "" {
+		return fmt.Errorf("unknown template: %s", config.SelectedTemplate)
+	}
 
-	templatePath := fmt.Sprintf("small/%s", normalizedName)
 	if err := common.BaseGenerate(config, templatePath); err != nil {
 		return err
 	}
@@ -36,16 +42,36 @@ func Generate(config core.ProjectConfig) error {
 	return nil
 }
 
+// generatePack generates a project from the files of a template pack and installs its dependencies.
+func generatePack(config core.ProjectConfig, pack packs.Pack) error {
+	if err := common.BaseGenerateFiles(config, pack.FS(), pack.Files); err != nil {
+		return fmt.Errorf("failed to render pack %s: %w", pack.Name, err)
+	}
+
+	if err := installDependencies(config, pack.Dependencies...); err != nil {
+		return fmt.Errorf("failed to install dependencies: %w", err)
+	}
+
+	if err := common.GenerateAddons(config); err != nil {
+		return fmt.Errorf("failed to generate addons: %w", err)
+	}
+
+	return nil
+}
+
 // installDependencies installs the required dependencies for the generated project based on the provided configuration.
 //
 // It will get the required packages for the SelectedFramework and SelectedDatabaseDriver fields.
 // If the SelectedDatabaseDriver field is empty or "none", it will not include the database driver packages.
 //
-// After getting the required packages, it will call GoGet to install them.
-// Finally, it will call GoModTidy and GoFmt to clean up the project directory.
+// After getting the required packages, it will call GoGet to install them, each pinned to its registry version.
+// Then it will call GoModTidy and GoFmt to clean up the project directory.
+// Finally, the resolved module versions are recorded in gocrafting-cli.json.
+//
+// Extra packages, such as the dependencies declared by a template pack, are installed along with them.
 //
 // Returns an error if there is an issue during the installation process.
-func installDependencies(config core.ProjectConfig) error {
+func installDependencies(config core.ProjectConfig, extra ...string) error {
 	var packages []string
 
 	// ---------------------------------------------------------
@@ -75,6 +101,24 @@ func installDependencies(config core.ProjectConfig) error {
 		packages = append(packages, core.GetPackages(config.SelectedDatabaseDriver)...)
 	}
 
+	// ---------------------------------------------------------
+	// 3. LOGIC BERDASARKAN TESTING STYLE
+	// ---------------------------------------------------------
+
+	packages = append(packages, core.TestingPackages(config.SelectedTestingFramework)...)
+
+	// ---------------------------------------------------------
+	// 4. LOGIC BERDASARKAN LOGGER
+	// ---------------------------------------------------------
+
+	packages = append(packages, core.LoggerPackages(config.SelectedLogger)...)
+
+	// ---------------------------------------------------------
+	// 5. DEPENDENCIES TAMBAHAN (Template Pack)
+	// ---------------------------------------------------------
+
+	packages = append(packages, extra...)
+
 	if len(packages) > 0 {
 		if err := shell.GoGet(config.ProjectName, packages...); err != nil {
 			return err
@@ -85,5 +129,13 @@ func installDependencies(config core.ProjectConfig) error {
 		return err
 	}
 
-	return shell.RunGoFmt(config.ProjectName)
+	if err := shell.RunGoFmt(config.ProjectName); err != nil {
+		return err
+	}
+
+	// ---------------------------------------------------------
+	// 6. CATAT VERSI YANG TERPASANG (gocrafting-cli.json)
+	// ---------------------------------------------------------
+
+	return core.RecordDependencies(config.ProjectName)
 }
@@ -0,0 +1,392 @@
+// Package lint executes every template (embedded defaults, overrides and template packs) against the
+// matrix of valid project configurations, so template mistakes surface before a user generates a project.
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"io/fs"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/generators"
+	"github.com/xRiot45/gocrafting/internal/packs"
+	"github.com/xRiot45/gocrafting/internal/scaffold"
+	"github.com/xRiot45/gocrafting/internal/templates"
+)
+
+// Scales are the project scales whose providers are linted; scales without a provider are skipped.
+var Scales = []string{"Small", "Medium", "Enterprise"}
+
+var (
+	// templateLinePattern finds the line reported by text/template, e.g. "template: common/readme.tmpl:12:5: ...".
+	templateLinePattern = regexp.MustCompile(`template: [^:\s]+:(\d+)`)
+	// actionPattern matches template actions, ignored when matching output lines back to template lines.
+	actionPattern = regexp.MustCompile(`{{.*?}}`)
+)
+
+// Issue is a template failure, reported once for every combination failing the same way.
+type Issue struct {
+	// Template is the template path, e.g. "small/fast-http/store.go.tmpl".
+	Template string
+	// Line is the template line the failure points at, 0 when it cannot be located.
+	Line int
+	// Message describes the failure.
+	Message string
+	// Config is the first combination that fails.
+	Config core.ProjectConfig
+	// Count is the number of combinations failing the same way.
+	Count int
+}
+
+// Report is the result of linting the templates.
+type Report struct {
+	// Combinations is the number of project configurations the templates were executed against.
+	Combinations int
+	// Renders is the number of template executions.
+	Renders int
+	// Issues are the failures found, in the order they were first seen.
+	Issues []Issue
+	// Skipped lists templates offered by a provider that have no template files.
+	Skipped []string
+}
+
+// Combinations returns every configuration the wizard can produce: each template of each provider
+// with each of its frameworks and database drivers, every testing style and logger,
+// and no add-ons or all of them.
+func Combinations() []core.ProjectConfig {
+	var allAddons []string
+	for _, addon := range core.AvailableAddons {
+		allAddons = append(allAddons, addon.ID)
+	}
+
+	var result []core.ProjectConfig
+	for _, scale := range Scales {
+		provider, err := generators.GetProvider(scale)
+		if err != nil {
+			continue
+		}
+
+		for _, template := range provider.GetTemplates() {
+			for _, framework := range orNone(provider.GetFrameworks(template)) {
+				for _, driver := range orNone(provider.GetDatabaseDrivers(template)) {
+					for _, testing := range core.TestingFrameworks {
+						for _, logger := range core.Loggers {
+							for _, addons := range [][]string{nil, allAddons} {
+								result = append(result, core.ProjectConfig{
+									ProjectName:              "lint-app",
+									ModuleName:               "example.com/lint-app",
+									ProjectScale:             scale,
+									SelectedTemplate:         template,
+									SelectedFramework:        framework,
+									SelectedDatabaseDriver:   driver,
+									SelectedTestingFramework: testing,
+									SelectedLogger:           logger,
+									SelectedAddons:           addons,
+								})
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// orNone returns options, or "None" when the wizard skips the step because there are none.
+func orNone(options []string) []string {
+	if len(options) == 0 {
+		return []string{"None"}
+	}
+	return options
+}
+
+// Describe formats a combination for reports, e.g. "Small / Fast HTTP / Gin / PostgreSQL / Testify / Zap / all add-ons".
+func Describe(config core.ProjectConfig) string {
+	addons := "no add-ons"
+	if len(config.SelectedAddons) > 0 {
+		addons = "all add-ons"
+	}
+	return strings.Join([]string{
+		config.ProjectScale, config.SelectedTemplate, config.SelectedFramework, config.SelectedDatabaseDriver,
+		config.TestingFramework(), config.SelectedLogger, addons,
+	}, " / ")
+}
+
+// linter collects the issues of one run.
+type linter struct {
+	report  Report
+	issues  map[string]int
+	skipped map[string]bool
+}
+
+// Run lints the project templates, add-on templates and template packs against every combination,
+// and the schematic templates against sample data of every distinct project setup.
+func Run() Report {
+	l := &linter{issues: map[string]int{}, skipped: map[string]bool{}}
+
+	projectEngine := templates.NewEngine("", templates.FS)
+	schematicEngine := templates.NewEngine("", scaffold.Templates())
+	schematicSetups := map[string]bool{}
+
+	combinations := Combinations()
+	l.report.Combinations = len(combinations)
+
+	for _, config := range combinations {
+		l.lintProject(projectEngine, config)
+
+		common, err := projectEngine.Sources("common", config)
+		if err != nil {
+			l.add("common", 0, err.Error(), config)
+		}
+		for _, source := range common {
+			l.lintFile(projectEngine, source, config, config)
+		}
+
+		// Schematic output only depends on the project setup, not on the template or add-ons
+		setup := strings.Join([]string{config.ProjectScale, config.SelectedFramework, config.SelectedDatabaseDriver,
+			config.SelectedTestingFramework, config.SelectedLogger}, "|")
+		if schematicSetups[setup] {
+			continue
+		}
+		schematicSetups[setup] = true
+
+		schematics, err := schematicEngine.Sources(".", config)
+		if err != nil {
+			l.add("schematics", 0, err.Error(), config)
+		}
+		// The samples only differ in their middleware kind, templates not reading .Kind are executed once
+		for i, data := range scaffold.SampleData(config) {
+			for _, source := range schematics {
+				if i == 0 || readsKind(schematicEngine, source) {
+					l.lintFile(schematicEngine, source, data, config)
+				}
+			}
+		}
+	}
+
+	return l.report
+}
+
+// lintProject lints the files of the selected template: the files of its pack, or its built-in folder.
+func (l *linter) lintProject(engine *templates.Engine, config core.ProjectConfig) {
+	provider, err := generators.GetProvider(config.ProjectScale)
+	if err != nil {
+		return
+	}
+
+	if pack, ok := packs.Find(config.ProjectScale, config.SelectedTemplate); ok && provider.GetTemplateDir(config.SelectedTemplate) == "" {
+		packEngine := templates.NewEngine("", pack.FS())
+		for _, file := range pack.Files {
+			l.lintFile(packEngine, file, config, config)
+		}
+		return
+	}
+
+	dir := provider.GetTemplateDir(config.SelectedTemplate)
+	sources, err := engine.Sources(dir, config)
+	if errors.Is(err, fs.ErrNotExist) || (err == nil && len(sources) == 0) {
+		l.skip(fmt.Sprintf("%s / %s: no template files in %s", config.ProjectScale, config.SelectedTemplate, dir))
+		return
+	}
+	if err != nil {
+		l.add(dir, 0, err.Error(), config)
+		return
+	}
+
+	for _, source := range sources {
+		l.lintFile(engine, source, config, config)
+	}
+}
+
+// lintFile executes one template and checks generated Go code with go/parser and gofmt.
+func (l *linter) lintFile(engine *templates.Engine, source string, data any, config core.ProjectConfig) {
+	l.report.Renders++
+
+	output, include, err := engine.Execute(source, data)
+	if err != nil {
+		l.add(source, templateLine(err), err.Error(), config)
+		return
+	}
+	if !include || !isGo(source, output) {
+		return
+	}
+
+	fset := token.NewFileSet()
+	if _, err := parser.ParseFile(fset, strings.TrimSuffix(source, ".tmpl"), output, parser.ParseComments); err != nil {
+		var list scanner.ErrorList
+		if errors.As(err, &list) && len(list) > 0 {
+			outputLine := list[0].Pos.Line
+			message := fmt.Sprintf("generated Go does not parse: %s (output line %d: %q)", list[0].Msg, outputLine, lineAt(output, outputLine))
+			l.add(source, l.sourceLine(engine, source, output, outputLine), message, config)
+			return
+		}
+		l.add(source, 0, "generated Go does not parse: "+err.Error(), config)
+		return
+	}
+
+	if _, err := format.Source(output); err != nil {
+		l.add(source, 0, "gofmt failed: "+err.Error(), config)
+	}
+}
+
+// add records an issue, counting combinations that fail with the same template, line and message.
+func (l *linter) add(template string, line int, message string, config core.ProjectConfig) {
+	key := template + ":" + strconv.Itoa(line) + ":" + stripOutputLine(message)
+	if index, ok := l.issues[key]; ok {
+		l.report.Issues[index].Count++
+		return
+	}
+
+	l.issues[key] = len(l.report.Issues)
+	l.report.Issues = append(l.report.Issues, Issue{Template: template, Line: line, Message: message, Config: config, Count: 1})
+}
+
+// skip records a template without files once.
+func (l *linter) skip(note string) {
+	if !l.skipped[note] {
+		l.skipped[note] = true
+		l.report.Skipped = append(l.report.Skipped, note)
+	}
+}
+
+// sourceLine maps a line of generated output back to the template line with the same literal text.
+// Candidates are ranked by how many of the preceding non-empty output lines match the template too,
+// then by distance to the output line. It returns 0 when no template line matches.
+func (l *linter) sourceLine(engine *templates.Engine, source string, output []byte, outputLine int) int {
+	content, err := engine.ReadFile(source)
+	if err != nil {
+		return 0
+	}
+
+	outputLines := nonEmptyBefore(strings.Split(string(output), "\n"), outputLine, 8)
+	if len(outputLines) == 0 {
+		return 0
+	}
+
+	var literals []*regexp.Regexp
+	for _, line := range strings.Split(string(content), "\n") {
+		literals = append(literals, literalPattern(line))
+	}
+
+	best, bestScore, bestDistance := 0, 0, 0
+	for i := range literals {
+		score := contextScore(literals, i, outputLines)
+		if score == 0 {
+			continue
+		}
+
+		distance := i + 1 - outputLine
+		if distance < 0 {
+			distance = -distance
+		}
+		if score > bestScore || (score == bestScore && distance < bestDistance) {
+			best, bestScore, bestDistance = i+1, score, distance
+		}
+	}
+	return best
+}
+
+// nonEmptyBefore returns up to n non-empty, trimmed lines ending at the 1-based line, the last one first.
+func nonEmptyBefore(lines []string, line, n int) []string {
+	var result []string
+	for i := line - 1; i >= 0 && i < len(lines) && len(result) < n; i-- {
+		if trimmed := strings.TrimSpace(lines[i]); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
+
+// contextScore counts how many of wanted (an output line, then the lines before it) match the
+// template lines ending at index, skipping template lines without literal text.
+func contextScore(literals []*regexp.Regexp, index int, wanted []string) int {
+	score := 0
+	for i := index; i >= 0 && score < len(wanted); i-- {
+		if literals[i] == nil {
+			if i == index {
+				return 0
+			}
+			continue
+		}
+		if !literals[i].MatchString(wanted[score]) {
+			break
+		}
+		score++
+	}
+	return score
+}
+
+// literalPattern turns a template line into a pattern matching its output, each action matching anything.
+// Lines made only of actions have no literal text and return nil.
+func literalPattern(line string) *regexp.Regexp {
+	line = strings.TrimSpace(line)
+	if strings.TrimSpace(actionPattern.ReplaceAllString(line, "")) == "" {
+		return nil
+	}
+
+	parts := actionPattern.Split(line, -1)
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile(`^\s*` + strings.Join(parts, ".*") + `\s*$`)
+}
+
+// readsKind reports whether the template at source uses the middleware kind.
+func readsKind(engine *templates.Engine, source string) bool {
+	content, err := engine.ReadFile(source)
+	return err == nil && strings.Contains(string(content), ".Kind")
+}
+
+// isGo reports whether the output of source is Go code: ".go" templates, or schematic templates
+// whose output starts with a package clause.
+func isGo(source string, output []byte) bool {
+	target := strings.TrimSuffix(source, ".tmpl")
+	if strings.HasSuffix(target, ".go") {
+		return true
+	}
+	if strings.Contains(baseName(target), ".") {
+		return false
+	}
+	_, err := parser.ParseFile(token.NewFileSet(), "", output, parser.PackageClauseOnly)
+	return err == nil
+}
+
+// baseName returns the last element of a slash-separated template path.
+func baseName(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// templateLine extracts the template line from a text/template error, 0 when there is none.
+func templateLine(err error) int {
+	match := templateLinePattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0
+	}
+	line, _ := strconv.Atoi(match[1])
+	return line
+}
+
+// lineAt returns the 1-based line of content.
+func lineAt(content []byte, line int) string {
+	lines := strings.Split(string(content), "\n")
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	return strings.TrimSpace(lines[line-1])
+}
+
+// stripOutputLine drops the output position from a message so equal failures group together.
+func stripOutputLine(message string) string {
+	if index := strings.Index(message, " (output line "); index >= 0 {
+		return message[:index]
+	}
+	return message
+}
@@ -0,0 +1,170 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/templates"
+)
+
+// brokenFS holds fixture templates that only fail for some combinations. Line numbers in the
+// tests below are lines of these files, front-matter included.
+var brokenFS = fstest.MapFS{
+	// Gin projects get an unclosed call on line 10
+	"small/api/main.go.tmpl": {Data: []byte(`---
+when: ne .SelectedDatabaseDriver "None"
+---
+package main
+
+import "fmt"
+
+func main() {
+{{- if eq .SelectedFramework "Gin" }}
+	fmt.Println("gin"
+{{- end }}
+	fmt.Println("done")
+}
+`)},
+	// Fiber projects read a field ProjectConfig does not have on line 6
+	"small/api/config.go.tmpl": {Data: []byte(`---
+# only rendered with a database
+when: ne .SelectedDatabaseDriver "None"
+---
+package main
+{{ if eq .SelectedFramework "Fiber" }}const name = "{{ .Missing }}"{{ end }}
+`)},
+	// An unclosed action on line 5 fails to parse for every combination
+	"small/api/broken.go.tmpl": {Data: []byte(`---
+when: true
+---
+package main
+const name = "{{ .ProjectName "
+`)},
+	"small/api/valid.go.tmpl": {Data: []byte(`package main
+
+const framework = "{{ .SelectedFramework }}"
+`)},
+}
+
+// lintFixture lints source from brokenFS against every framework and database, like Run does.
+func lintFixture(t *testing.T, source string) Report {
+	t.Helper()
+
+	// Keep user-level and project-local overrides out of the engine
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Chdir(t.TempDir())
+
+	engine := templates.NewEngine("", brokenFS)
+	l := &linter{issues: map[string]int{}, skipped: map[string]bool{}}
+	for _, framework := range []string{"Fiber", "Gin"} {
+		for _, driver := range []string{"None", "SQLite", "PostgreSQL"} {
+			config := core.ProjectConfig{
+				ProjectName:            "lint-app",
+				ProjectScale:           "Small",
+				SelectedTemplate:       "Fast HTTP",
+				SelectedFramework:      framework,
+				SelectedDatabaseDriver: driver,
+			}
+			l.lintFile(engine, source, config, config)
+		}
+	}
+	return l.report
+}
+
+func TestLintFileReportsTemplateLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		line      int
+		framework string
+		driver    string
+		count     int
+		message   string
+	}{
+		{
+			name:      "generated Go does not parse",
+			source:    "small/api/main.go.tmpl",
+			line:      10,
+			framework: "Gin",
+			driver:    "SQLite",
+			count:     2,
+			message:   "generated Go does not parse",
+		},
+		{
+			name:      "execution error after front-matter",
+			source:    "small/api/config.go.tmpl",
+			line:      6,
+			framework: "Fiber",
+			driver:    "SQLite",
+			count:     2,
+			message:   "can't evaluate field Missing",
+		},
+		{
+			name:      "parse error after front-matter",
+			source:    "small/api/broken.go.tmpl",
+			line:      5,
+			framework: "Fiber",
+			driver:    "None",
+			count:     6,
+			message:   "failed to parse template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := lintFixture(t, tt.source)
+			if len(report.Issues) != 1 {
+				t.Fatalf("got %d issues, want 1: %+v", len(report.Issues), report.Issues)
+			}
+
+			issue := report.Issues[0]
+			if issue.Template != tt.source || issue.Line != tt.line {
+				t.Errorf("issue at %s:%d, want %s:%d (%s)", issue.Template, issue.Line, tt.source, tt.line, issue.Message)
+			}
+			if issue.Config.SelectedFramework != tt.framework || issue.Config.SelectedDatabaseDriver != tt.driver {
+				t.Errorf("issue reported for %s, want the first failing combination %s / %s", Describe(issue.Config), tt.framework, tt.driver)
+			}
+			if issue.Count != tt.count {
+				t.Errorf("issue count = %d, want %d", issue.Count, tt.count)
+			}
+			if !strings.Contains(issue.Message, tt.message) {
+				t.Errorf("issue message = %q, want %q", issue.Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestLintFileValidTemplate(t *testing.T) {
+	report := lintFixture(t, "small/api/valid.go.tmpl")
+	if len(report.Issues) != 0 {
+		t.Errorf("got issues for a valid template: %+v", report.Issues)
+	}
+	if report.Renders != 6 {
+		t.Errorf("Renders = %d, want 6", report.Renders)
+	}
+}
+
+func TestLiteralPattern(t *testing.T) {
+	tests := []struct {
+		line    string
+		output  string
+		matches bool
+	}{
+		{line: `	fmt.Println("{{ .Name }}")`, output: `fmt.Println("app")`, matches: true},
+		{line: `const name = "{{ .Name }}"`, output: `const name = "a" + "b"`, matches: true},
+		{line: `	fmt.Println("gin"`, output: `fmt.Println("fiber"`, matches: false},
+		{line: `{{- if .Gin }}{{ end }}`, output: ``, matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			pattern := literalPattern(tt.line)
+			got := pattern != nil && pattern.MatchString(tt.output)
+			if got != tt.matches {
+				t.Errorf("literalPattern(%q) matches %q = %v, want %v", tt.line, tt.output, got, tt.matches)
+			}
+		})
+	}
+}
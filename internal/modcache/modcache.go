@@ -0,0 +1,110 @@
+// Package modcache looks up modules in the local Go module cache, so dependencies can be
+// pinned to versions that are already downloaded and installed without network access.
+package modcache
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// Cache is the download directory of a module cache, laid out like a GOPROXY.
+type Cache struct {
+	dir string
+}
+
+// Open returns the module cache of the installed Go toolchain ('go env GOMODCACHE').
+func Open() (*Cache, error) {
+	output, err := exec.Command("go", "env", "GOMODCACHE").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate the module cache: %w", err)
+	}
+
+	dir := strings.TrimSpace(string(output))
+	if dir == "" {
+		return nil, fmt.Errorf("failed to locate the module cache: GOMODCACHE is empty")
+	}
+	return &Cache{dir: filepath.Join(dir, "cache", "download")}, nil
+}
+
+// At returns the cache laid out in dir, e.g. a GOPROXY=file:// directory.
+func At(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// Dir returns the download directory, usable as GOPROXY=file://<dir>.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// Latest returns the module providing pkg and its newest downloaded version. Module paths are tried
+// from pkg itself up to its first element, so "go.mongodb.org/mongo-driver/mongo" resolves to the
+// "go.mongodb.org/mongo-driver" module. Releases win over pre-releases and pseudo-versions.
+func (c *Cache) Latest(pkg string) (module.Version, bool) {
+	for modPath := pkg; modPath != "." && modPath != "/"; modPath = path.Dir(modPath) {
+		if version := c.latestVersion(modPath); version != "" {
+			return module.Version{Path: modPath, Version: version}, true
+		}
+	}
+	return module.Version{}, false
+}
+
+// Has reports whether the source of modPath at version has been downloaded.
+func (c *Cache) Has(modPath, version string) bool {
+	escapedPath, err := module.EscapePath(modPath)
+	if err != nil {
+		return false
+	}
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return false
+	}
+
+	_, err = os.Stat(filepath.Join(c.dir, filepath.FromSlash(escapedPath), "@v", escapedVersion+".zip"))
+	return err == nil
+}
+
+// latestVersion returns the newest downloaded version of modPath, "" when there is none.
+func (c *Cache) latestVersion(modPath string) string {
+	escaped, err := module.EscapePath(modPath)
+	if err != nil {
+		return ""
+	}
+
+	entries, err := os.ReadDir(filepath.Join(c.dir, filepath.FromSlash(escaped), "@v"))
+	if err != nil {
+		return ""
+	}
+
+	latest := ""
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".zip")
+		if !ok {
+			continue
+		}
+
+		version, err := module.UnescapeVersion(name)
+		if err != nil || !semver.IsValid(version) {
+			continue
+		}
+		if latest == "" || newer(version, latest) {
+			latest = version
+		}
+	}
+	return latest
+}
+
+// newer reports whether version should be preferred over current.
+func newer(version, current string) bool {
+	isRelease, currentIsRelease := semver.Prerelease(version) == "", semver.Prerelease(current) == ""
+	if isRelease != currentIsRelease {
+		return isRelease
+	}
+	return semver.Compare(version, current) > 0
+}
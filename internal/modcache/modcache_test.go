@@ -0,0 +1,105 @@
+package modcache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newCache lays out a download directory holding a .zip for every module@version in modules,
+// with module paths and versions escaped like the module cache does.
+func newCache(t *testing.T, modules map[string][]string) *Cache {
+	t.Helper()
+
+	dir := t.TempDir()
+	for escapedPath, versions := range modules {
+		versionDir := filepath.Join(dir, filepath.FromSlash(escapedPath), "@v")
+		if err := os.MkdirAll(versionDir, 0750); err != nil {
+			t.Fatal(err)
+		}
+		for _, version := range versions {
+			for _, ext := range []string{".info", ".mod", ".zip"} {
+				if err := os.WriteFile(filepath.Join(versionDir, version+ext), nil, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+	return At(dir)
+}
+
+func TestLatest(t *testing.T) {
+	cache := newCache(t, map[string][]string{
+		"github.com/lib/pq":              {"v1.10.9", "v1.12.3", "v1.2.0"},
+		"github.com/gin-gonic/gin":       {"v1.12.0", "v1.13.0-rc.1", "v0.0.0-20260101000000-abcdefabcdef"},
+		"github.com/!burnt!sushi/toml":   {"v1.5.0"},
+		"go.mongodb.org/mongo-driver":    {"v1.17.4"},
+		"example.com/prerelease":         {"v2.0.0-beta.1", "v2.0.0-alpha.3"},
+		"example.com/pseudo":             {"v0.0.0-20250101000000-abcdefabcdef", "v0.0.0-20260101000000-abcdefabcdef"},
+		"example.com/invalid":            {"latest", "1.0.0"},
+		"example.com/nested/module/v2":   {"v2.1.0"},
+		"example.com/nested":             {"v1.0.0"},
+		"github.com/mattn/go-sqlite3/!x": {"v1.0.0"},
+	})
+
+	tests := []struct {
+		pkg     string
+		path    string
+		version string
+	}{
+		{pkg: "github.com/lib/pq", path: "github.com/lib/pq", version: "v1.12.3"},
+		{pkg: "github.com/gin-gonic/gin", path: "github.com/gin-gonic/gin", version: "v1.12.0"},
+		{pkg: "github.com/gin-gonic/gin/binding", path: "github.com/gin-gonic/gin", version: "v1.12.0"},
+		{pkg: "github.com/BurntSushi/toml", path: "github.com/BurntSushi/toml", version: "v1.5.0"},
+		{pkg: "go.mongodb.org/mongo-driver/mongo/options", path: "go.mongodb.org/mongo-driver", version: "v1.17.4"},
+		{pkg: "example.com/prerelease", path: "example.com/prerelease", version: "v2.0.0-beta.1"},
+		{pkg: "example.com/pseudo", path: "example.com/pseudo", version: "v0.0.0-20260101000000-abcdefabcdef"},
+		{pkg: "example.com/nested/module/v2/pkg", path: "example.com/nested/module/v2", version: "v2.1.0"},
+		{pkg: "example.com/invalid"},
+		{pkg: "github.com/unknown/module"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pkg, func(t *testing.T) {
+			got, ok := cache.Latest(tt.pkg)
+			if ok != (tt.version != "") || got.Path != tt.path || got.Version != tt.version {
+				t.Errorf("Latest(%q) = %v, %v; want %s@%s", tt.pkg, got, ok, tt.path, tt.version)
+			}
+		})
+	}
+}
+
+func TestHas(t *testing.T) {
+	cache := newCache(t, map[string][]string{
+		"github.com/lib/pq":            {"v1.12.3"},
+		"github.com/!burnt!sushi/toml": {"v1.5.0"},
+		"example.com/upper":            {"v1.0.0-!r!c1"},
+	})
+	// A version with only its go.mod downloaded cannot be built from
+	if err := os.WriteFile(filepath.Join(cache.Dir(), "github.com", "lib", "pq", "@v", "v1.10.9.mod"), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path    string
+		version string
+		want    bool
+	}{
+		{path: "github.com/lib/pq", version: "v1.12.3", want: true},
+		{path: "github.com/lib/pq", version: "v1.10.9", want: false},
+		{path: "github.com/lib/pq", version: "v1.0.0", want: false},
+		{path: "github.com/BurntSushi/toml", version: "v1.5.0", want: true},
+		{path: "github.com/burntsushi/toml", version: "v1.5.0", want: false},
+		{path: "example.com/upper", version: "v1.0.0-RC1", want: true},
+		{path: "github.com/lib", version: "v1.12.3", want: false},
+		{path: "../escape", version: "v1.0.0", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path+"@"+tt.version, func(t *testing.T) {
+			if got := cache.Has(tt.path, tt.version); got != tt.want {
+				t.Errorf("Has(%q, %q) = %v, want %v", tt.path, tt.version, got, tt.want)
+			}
+		})
+	}
+}
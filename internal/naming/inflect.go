@@ -0,0 +1,189 @@
+package naming
+
+import (
+	"strings"
+	"unicode"
+)
+
+// irregulars maps singular words to plurals that do not follow the suffix rules.
+var irregulars = map[string]string{
+	"person": "people",
+	"man":    "men",
+	"woman":  "women",
+	"child":  "children",
+	"tooth":  "teeth",
+	"foot":   "feet",
+	"mouse":  "mice",
+	"goose":  "geese",
+	"ox":     "oxen",
+	"leaf":   "leaves",
+	"life":   "lives",
+	"knife":  "knives",
+	"wife":   "wives",
+	"half":   "halves",
+	"shelf":  "shelves",
+	"wolf":   "wolves",
+	"thief":  "thieves",
+	"hero":   "heroes",
+	"potato": "potatoes",
+	"tomato": "tomatoes",
+	"echo":   "echoes",
+	"status": "statuses",
+	"bonus":  "bonuses",
+	"campus": "campuses",
+	"virus":  "viruses",
+	"bus":    "buses",
+	"cache":  "caches",
+	"movie":  "movies",
+	"cookie": "cookies",
+	"crisis": "crises",
+	"thesis": "theses",
+	"basis":  "bases",
+	"axis":   "axes",
+	// Singular words ending in "s" that would otherwise be taken for plurals
+	"alias":  "aliases",
+	"atlas":  "atlases",
+	"bias":   "biases",
+	"canvas": "canvases",
+	"gas":    "gases",
+	"iris":   "irises",
+	"lens":   "lenses",
+	"census": "censuses",
+	"corpus": "corpora",
+}
+
+// uncountables have the same singular and plural form.
+var uncountables = map[string]bool{
+	"data": true, "metadata": true, "equipment": true, "information": true, "money": true,
+	"news": true, "series": true, "species": true, "sheep": true, "fish": true, "feedback": true,
+	"media": true, "staff": true, "software": true, "inventory": true, "audio": true, "cors": true,
+	"sms": true, "chassis": true, "gps": true,
+}
+
+// singulars is the reverse of irregulars.
+var singulars = func() map[string]string {
+	m := make(map[string]string, len(irregulars))
+	for singular, plural := range irregulars {
+		m[plural] = singular
+	}
+	return m
+}()
+
+// Plural returns the plural of the last word of name, keeping its style:
+// "category" -> "categories", "OrderItem" -> "OrderItems", "order_item" -> "order_items".
+// Names that are already plural are returned unchanged.
+func Plural(name string) string {
+	return inflectLastWord(name, pluralWord)
+}
+
+// Singular returns the singular of the last word of name, keeping its style: "order_items" -> "order_item".
+func Singular(name string) string {
+	return inflectLastWord(name, singularWord)
+}
+
+// inflectLastWord applies fn to the trailing word of name (split like Words) and restores its case.
+func inflectLastWord(name string, fn func(string) string) string {
+	words := Words(name)
+	if len(words) == 0 {
+		return name
+	}
+
+	last := words[len(words)-1]
+	start := strings.LastIndex(strings.ToLower(name), last)
+	if start < 0 {
+		return name
+	}
+
+	original := name[start : start+len(last)]
+	inflected := fn(last)
+
+	stem := strings.TrimSuffix(original, "s")
+
+	switch {
+	case initialisms[last] && original == strings.ToUpper(original) && !strings.ContainsAny(name, "_-"):
+		// Initialisms keep their case and get a lower case suffix: "UserID" -> "UserIDs", "ID" -> "IDs"
+		inflected = original + inflected[len(last):]
+	case initialisms[strings.ToLower(stem)] && stem == strings.ToUpper(stem) && inflected == strings.ToLower(stem):
+		// and lose it again: "UserIDs" -> "UserID"
+		inflected = stem
+	case name == strings.ToUpper(name):
+		// SCREAMING_CASE stays upper case
+		inflected = strings.ToUpper(inflected)
+	case unicode.IsUpper([]rune(original)[0]):
+		inflected = strings.ToUpper(inflected[:1]) + inflected[1:]
+	}
+
+	return name[:start] + inflected + name[start+len(last):]
+}
+
+// pluralWord pluralizes a single lowercase word.
+func pluralWord(word string) string {
+	if uncountables[word] || singulars[word] != "" {
+		return word
+	}
+	if plural, ok := irregulars[word]; ok {
+		return plural
+	}
+	if isPlural(word) {
+		return word
+	}
+
+	switch {
+	case strings.HasSuffix(word, "quiz"):
+		return word + "zes"
+	case strings.HasSuffix(word, "is") && len(word) > 3:
+		return word[:len(word)-2] + "es"
+	case hasAnySuffix(word, "s", "x", "z", "ch", "sh"):
+		return word + "es"
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !isVowel(word[len(word)-2]):
+		return word[:len(word)-1] + "ies"
+	}
+	return word + "s"
+}
+
+// singularWord singularizes a single lowercase word.
+func singularWord(word string) string {
+	if uncountables[word] {
+		return word
+	}
+	if singular, ok := singulars[word]; ok {
+		return singular
+	}
+	// Known singulars such as "gas" or "lens" already end in "s"
+	if _, ok := irregulars[word]; ok {
+		return word
+	}
+
+	switch {
+	case strings.HasSuffix(word, "quizzes"):
+		return word[:len(word)-3]
+	case strings.HasSuffix(word, "ies") && len(word) > 4:
+		return word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "yses"):
+		return word[:len(word)-2] + "is"
+	case hasAnySuffix(word, "sses", "shes", "ches", "xes"):
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "s") && !hasAnySuffix(word, "ss", "us", "is"):
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// isPlural reports whether word looks like a regular plural, i.e. singularizing and pluralizing it again is stable.
+func isPlural(word string) bool {
+	singular := singularWord(word)
+	return singular != word && pluralWord(singular) == word
+}
+
+func hasAnySuffix(word string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) >= 0
+}
@@ -0,0 +1,105 @@
+// Package naming derives Go identifiers, file names, routes and plural forms from user input.
+// Any style is accepted: "order_item", "order-item", "OrderItem" and "orderItem" are the same name.
+package naming
+
+import (
+	"strings"
+	"unicode"
+)
+
+// initialisms are kept upper case in Go identifiers (user_id -> UserID).
+var initialisms = map[string]bool{
+	"api": true, "aws": true, "cors": true, "db": true, "dsn": true, "html": true, "http": true,
+	"id": true, "ip": true, "json": true, "jwt": true, "smtp": true, "sql": true, "ssl": true,
+	"tls": true, "ttl": true, "uid": true, "url": true, "uri": true, "uuid": true,
+}
+
+// Words splits a name into lowercase words on separators, case changes and digits.
+// "HTTPServer" gives ["http", "server"], "order_item2" gives ["order", "item2"].
+func Words(name string) []string {
+	var words []string
+	var current []rune
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, strings.ToLower(string(current)))
+			current = nil
+		}
+	}
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+
+		if unicode.IsUpper(r) && len(current) > 0 {
+			prev := current[len(current)-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1]) && !isPluralSuffix(runes, i+1)
+			// Split "orderItem" before "I" and "HTTPServer" before "S"
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+
+		current = append(current, r)
+	}
+	flush()
+
+	return words
+}
+
+// isPluralSuffix reports whether runes[i] is the lower case "s" ending an upper case run,
+// which pluralizes an initialism ("IDs") rather than starting a new word.
+func isPluralSuffix(runes []rune, i int) bool {
+	return runes[i] == 's' && (i+1 == len(runes) || !unicode.IsLower(runes[i+1]))
+}
+
+// Pascal returns the exported Go identifier of name, honoring initialisms: "user_id" -> "UserID".
+func Pascal(name string) string {
+	var b strings.Builder
+	for _, word := range Words(name) {
+		b.WriteString(capitalize(word))
+	}
+	return b.String()
+}
+
+// Camel returns the unexported Go identifier of name: "order_item" -> "orderItem", "HTTPClient" -> "httpClient".
+func Camel(name string) string {
+	words := Words(name)
+	if len(words) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(words[0])
+	for _, word := range words[1:] {
+		b.WriteString(capitalize(word))
+	}
+	return b.String()
+}
+
+// Snake returns the snake_case form used for files, tables and columns: "OrderItem" -> "order_item".
+func Snake(name string) string {
+	return strings.Join(Words(name), "_")
+}
+
+// Kebab returns the kebab-case form used for routes: "OrderItem" -> "order-item".
+func Kebab(name string) string {
+	return strings.Join(Words(name), "-")
+}
+
+// capitalize upper cases the first letter of a word, or the whole word when it is an initialism
+// (keeping the plural "s" of "IDs" lower case).
+func capitalize(word string) string {
+	if initialisms[word] {
+		return strings.ToUpper(word)
+	}
+	if stem, ok := strings.CutSuffix(word, "s"); ok && initialisms[stem] {
+		return strings.ToUpper(stem) + "s"
+	}
+	runes := []rune(word)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,96 @@
+package naming
+
+import "testing"
+
+func TestPlural(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"user", "users"},
+		{"category", "categories"},
+		{"OrderItem", "OrderItems"},
+		{"order_item", "order_items"},
+		{"box", "boxes"},
+		{"person", "people"},
+		{"status", "statuses"},
+		{"users", "users"},
+		{"data", "data"},
+		{"alias", "aliases"},
+		{"canvas", "canvases"},
+		{"gas", "gases"},
+		{"lens", "lenses"},
+		{"sms", "sms"},
+		{"ID", "IDs"},
+		{"UserID", "UserIDs"},
+		{"user_id", "user_ids"},
+		{"USER_ID", "USER_IDS"},
+		{"Gas", "Gases"},
+	}
+	for _, tt := range tests {
+		if got := Plural(tt.name); got != tt.want {
+			t.Errorf("Plural(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSingular(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"users", "user"},
+		{"categories", "category"},
+		{"order_items", "order_item"},
+		{"boxes", "box"},
+		{"people", "person"},
+		{"statuses", "status"},
+		{"status", "status"},
+		{"user", "user"},
+		{"gas", "gas"},
+		{"gases", "gas"},
+		{"canvas", "canvas"},
+		{"lens", "lens"},
+		{"lenses", "lens"},
+		{"alias", "alias"},
+		{"sms", "sms"},
+		{"analyses", "analysis"},
+		{"UserIDs", "UserID"},
+		{"IDs", "ID"},
+	}
+	for _, tt := range tests {
+		if got := Singular(tt.name); got != tt.want {
+			t.Errorf("Singular(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCases(t *testing.T) {
+	tests := []struct {
+		name                        string
+		snake, kebab, pascal, camel string
+	}{
+		{"order_item", "order_item", "order-item", "OrderItem", "orderItem"},
+		{"OrderItem", "order_item", "order-item", "OrderItem", "orderItem"},
+		{"order-item", "order_item", "order-item", "OrderItem", "orderItem"},
+		{"HTTPServer", "http_server", "http-server", "HTTPServer", "httpServer"},
+		{"user_id", "user_id", "user-id", "UserID", "userID"},
+		{"UserIDs", "user_ids", "user-ids", "UserIDs", "userIDs"},
+		{"gas", "gas", "gas", "Gas", "gas"},
+		{"order_item2", "order_item2", "order-item2", "OrderItem2", "orderItem2"},
+	}
+	for _, tt := range tests {
+		if got := Snake(tt.name); got != tt.snake {
+			t.Errorf("Snake(%q) = %q, want %q", tt.name, got, tt.snake)
+		}
+		if got := Kebab(tt.name); got != tt.kebab {
+			t.Errorf("Kebab(%q) = %q, want %q", tt.name, got, tt.kebab)
+		}
+		if got := Pascal(tt.name); got != tt.pascal {
+			t.Errorf("Pascal(%q) = %q, want %q", tt.name, got, tt.pascal)
+		}
+		if got := Camel(tt.name); got != tt.camel {
+			t.Errorf("Camel(%q) = %q, want %q", tt.name, got, tt.camel)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package packs
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxFileSize caps a single file extracted from a pack archive.
+const maxFileSize = 10 << 20
+
+// Install copies the pack at source (a directory, .tar, .tar.gz or .tgz) into destRoot/<name>.
+// It only reads the local filesystem. An installed pack with the same name is replaced when force is set.
+func Install(source, destRoot string, force bool) (*Pack, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("pack source not found: %w", err)
+	}
+
+	packDir := source
+	if !info.IsDir() {
+		tempDir, err := os.MkdirTemp("", "gocrafting-pack-*")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create temp dir: %w", err)
+		}
+		defer func() { _ = os.RemoveAll(tempDir) }()
+
+		if err := extractArchive(source, tempDir); err != nil {
+			return nil, err
+		}
+
+		if packDir, err = manifestRoot(tempDir); err != nil {
+			return nil, fmt.Errorf("%w in %s", err, source)
+		}
+	}
+
+	pack, err := Load(packDir)
+	if err != nil {
+		return nil, err
+	}
+
+	target := filepath.Join(destRoot, pack.Name)
+	if overlaps(packDir, target) {
+		// Replacing or copying into the source itself would delete or endlessly copy it
+		return nil, fmt.Errorf("pack source %s overlaps the install directory %s", source, target)
+	}
+
+	if _, err := os.Stat(target); err == nil {
+		if !force {
+			return nil, fmt.Errorf("pack '%s' is already installed in %s, use --force to replace it", pack.Name, target)
+		}
+		if err := os.RemoveAll(target); err != nil {
+			return nil, fmt.Errorf("failed to remove the installed pack: %w", err)
+		}
+	}
+
+	if err := copyDir(packDir, target); err != nil {
+		return nil, err
+	}
+
+	return Load(target)
+}
+
+// overlaps reports whether one of the two paths is, or is inside, the other once symlinks are resolved.
+func overlaps(a, b string) bool {
+	a, b = resolvePath(a), resolvePath(b)
+	return a == b || isWithin(a, b) || isWithin(b, a)
+}
+
+// isWithin reports whether path is inside dir.
+func isWithin(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolvePath returns the absolute path with symlinks resolved in its longest existing prefix,
+// so a target that is not created yet still compares equal to the same directory reached through a link.
+func resolvePath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+
+	rest := ""
+	for current := abs; ; current = filepath.Dir(current) {
+		if resolved, err := filepath.EvalSymlinks(current); err == nil {
+			return filepath.Join(resolved, rest)
+		}
+		if filepath.Dir(current) == current {
+			return abs
+		}
+		rest = filepath.Join(filepath.Base(current), rest)
+	}
+}
+
+// manifestRoot returns dir when it holds the manifest, or its single top-level folder
+// when the archive wraps the pack in one (e.g. "event-consumer/gocrafting-pack.json").
+func manifestRoot(dir string) (string, error) {
+	if _, err := os.Stat(filepath.Join(dir, ManifestFile)); err == nil {
+		return dir, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err == nil && len(entries) == 1 && entries[0].IsDir() {
+		nested := filepath.Join(dir, entries[0].Name())
+		if _, err := os.Stat(filepath.Join(nested, ManifestFile)); err == nil {
+			return nested, nil
+		}
+	}
+	return "", fmt.Errorf("%s not found", ManifestFile)
+}
+
+// extractArchive unpacks a tar archive, gzip-compressed or not, into dest.
+func extractArchive(source, dest string) error {
+	// #nosec G304 -- The archive is a local file chosen by the user.
+	file, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", source, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(source, ".gz") || strings.HasSuffix(source, ".tgz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", source, err)
+		}
+		defer func() { _ = gz.Close() }()
+		reader = gz
+	}
+
+	archive := tar.NewReader(reader)
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", source, err)
+		}
+
+		// Reject entries escaping dest, e.g. "../../.bashrc"
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if !fs.ValidPath(filepath.ToSlash(name)) {
+			return fmt.Errorf("archive entry '%s' is outside the pack", header.Name)
+		}
+		target := filepath.Join(dest, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0750); err != nil {
+				return fmt.Errorf("failed to create %s: %w", target, err)
+			}
+		case tar.TypeReg:
+			if header.Size > maxFileSize {
+				return fmt.Errorf("archive entry '%s' is larger than %d bytes", header.Name, maxFileSize)
+			}
+			if err := writeFile(target, io.LimitReader(archive, maxFileSize)); err != nil {
+				return err
+			}
+		default:
+			// Links and special files are not part of a pack
+		}
+	}
+}
+
+// copyDir copies the regular files of src into dst.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(current string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(src, current)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, relPath)
+
+		if d.IsDir() {
+			return os.MkdirAll(target, 0750)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		// #nosec G304 -- current is a file inside the pack being installed.
+		file, err := os.Open(current)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", current, err)
+		}
+		defer func() { _ = file.Close() }()
+
+		return writeFile(target, file)
+	})
+}
+
+// writeFile writes the content of r to path, creating its directory.
+func writeFile(path string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+
+	// #nosec G304 -- path is inside the pack directory being written.
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	if _, err := io.Copy(file, r); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return file.Close()
+}
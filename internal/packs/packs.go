@@ -0,0 +1,182 @@
+// Package packs loads template packs: project templates shipped outside the CLI,
+// described by a gocrafting-pack.json manifest next to their template files.
+package packs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/xRiot45/gocrafting/internal/templates"
+)
+
+// ManifestFile is the name of the manifest at the root of every pack.
+const ManifestFile = "gocrafting-pack.json"
+
+// ProjectDir is the project-local pack directory, relative to the working directory.
+var ProjectDir = filepath.Join(".gocrafting", "packs")
+
+// namePattern restricts pack names to slugs usable as directory names, e.g. "event-consumer".
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// scales are the project scales a pack can target. Only the Small provider offers packs in the wizard,
+// so a Medium or Enterprise pack could be installed but never used.
+var scales = map[string]bool{"Small": true}
+
+// Manifest describes a template pack.
+type Manifest struct {
+	// Name identifies the pack and names its install directory, e.g. "event-consumer".
+	Name string `json:"name"`
+	// Template is the label shown in the wizard next to the built-in templates, e.g. "Event Consumer".
+	Template    string `json:"template"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version,omitempty"`
+	// Scale is the project scale the template belongs to; only Small is supported.
+	Scale string `json:"scale"`
+	// Frameworks and DatabaseDrivers are offered in the wizard; empty means the step is skipped.
+	Frameworks      []string `json:"frameworks,omitempty"`
+	DatabaseDrivers []string `json:"database_drivers,omitempty"`
+	// Dependencies are installed with go get after the files are written; pin them like
+	// "github.com/gin-gonic/gin@v1.12.0" for reproducible projects, bare paths install the latest version.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// Files are the templates of the pack, relative to its root; ".tmpl" files are rendered and lose the suffix.
+	Files []string `json:"files"`
+}
+
+// Pack is a manifest together with the directory holding its files.
+type Pack struct {
+	Manifest
+	Dir string
+}
+
+// FS returns the files of the pack.
+func (p Pack) FS() fs.FS {
+	return os.DirFS(p.Dir)
+}
+
+// UserDir returns the user-level pack directory, ~/.config/gocrafting/packs.
+func UserDir() (string, error) {
+	configDir, err := templates.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "packs"), nil
+}
+
+// Dirs returns the pack directories in lookup order: project-local first, then user-level.
+func Dirs() []string {
+	dirs := []string{ProjectDir}
+	if userDir, err := UserDir(); err == nil {
+		dirs = append(dirs, userDir)
+	}
+	return dirs
+}
+
+// Load reads and validates the pack in dir.
+func Load(dir string) (*Pack, error) {
+	// #nosec G304 -- The manifest path is built from a pack directory chosen by the user.
+	content, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", ManifestFile, err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse %s in %s: %w", ManifestFile, dir, err)
+	}
+
+	pack := &Pack{Manifest: manifest, Dir: dir}
+	if err := pack.validate(); err != nil {
+		return nil, fmt.Errorf("invalid pack in %s: %w", dir, err)
+	}
+	return pack, nil
+}
+
+// validate checks the manifest and that every declared file exists inside the pack.
+func (p Pack) validate() error {
+	switch {
+	case !namePattern.MatchString(p.Name):
+		return fmt.Errorf("name '%s' must be lowercase letters, digits, '.', '_' or '-'", p.Name)
+	case p.Template == "":
+		return fmt.Errorf("template is required")
+	case !scales[p.Scale]:
+		return fmt.Errorf("scale '%s' is not supported, packs can only target Small projects", p.Scale)
+	case len(p.Files) == 0:
+		return fmt.Errorf("files must list at least one template")
+	}
+
+	hasGoMod := false
+	for _, file := range p.Files {
+		if !fs.ValidPath(file) || path.Clean(file) != file {
+			return fmt.Errorf("file '%s' must be a relative path inside the pack", file)
+		}
+		if info, err := fs.Stat(p.FS(), file); err != nil || info.IsDir() {
+			return fmt.Errorf("file '%s' is declared but missing", file)
+		}
+		if file == "go.mod" || file == "go.mod.tmpl" {
+			hasGoMod = true
+		}
+	}
+
+	if !hasGoMod {
+		return fmt.Errorf("files must include go.mod.tmpl so dependencies can be installed")
+	}
+	return nil
+}
+
+// List returns the installed packs sorted by template name. A pack installed in the project
+// shadows a user-level pack with the same name; directories without a valid manifest are skipped.
+func List() []Pack {
+	seen := map[string]bool{}
+	var result []Pack
+
+	for _, dir := range Dirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() || seen[entry.Name()] {
+				continue
+			}
+
+			pack, err := Load(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				continue
+			}
+
+			seen[entry.Name()] = true
+			result = append(result, *pack)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Template < result[j].Template })
+	return result
+}
+
+// ForScale returns the installed packs of the given project scale.
+func ForScale(scale string) []Pack {
+	var result []Pack
+	for _, pack := range List() {
+		if pack.Scale == scale {
+			result = append(result, pack)
+		}
+	}
+	return result
+}
+
+// Find returns the installed pack providing the template of the given scale.
+func Find(scale, template string) (*Pack, bool) {
+	for _, pack := range ForScale(scale) {
+		if pack.Template == template {
+			return &pack, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,251 @@
+package packs
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// validManifest returns a manifest whose files writePack creates.
+func validManifest() Manifest {
+	return Manifest{
+		Name:     "event-consumer",
+		Template: "Event Consumer",
+		Scale:    "Small",
+		Files:    []string{"go.mod.tmpl", "cmd/main.go.tmpl"},
+	}
+}
+
+// writePack writes manifest and the files it declares into a new temp dir and returns it.
+func writePack(t *testing.T, manifest Manifest, files ...string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ManifestFile), content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range files {
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("module {{.ModuleName}}\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLoadValidatesManifest(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(m *Manifest)
+		files  []string
+		want   string
+	}{
+		{name: "valid", modify: func(*Manifest) {}},
+		{name: "uppercase name", modify: func(m *Manifest) { m.Name = "Event" }, want: "name 'Event'"},
+		{name: "name with slash", modify: func(m *Manifest) { m.Name = "../event" }, want: "name '../event'"},
+		{name: "missing template", modify: func(m *Manifest) { m.Template = "" }, want: "template is required"},
+		{name: "medium scale", modify: func(m *Manifest) { m.Scale = "Medium" }, want: "packs can only target Small projects"},
+		{name: "enterprise scale", modify: func(m *Manifest) { m.Scale = "Enterprise" }, want: "packs can only target Small projects"},
+		{name: "no files", modify: func(m *Manifest) { m.Files = nil }, want: "at least one template"},
+		{name: "parent file", modify: func(m *Manifest) { m.Files = append(m.Files, "../secret") }, want: "relative path inside the pack"},
+		{name: "absolute file", modify: func(m *Manifest) { m.Files = append(m.Files, "/etc/passwd") }, want: "relative path inside the pack"},
+		{name: "unclean file", modify: func(m *Manifest) { m.Files = append(m.Files, "cmd/../go.mod.tmpl") }, want: "relative path inside the pack"},
+		{name: "missing file", modify: func(m *Manifest) { m.Files = append(m.Files, "README.md") }, want: "'README.md' is declared but missing"},
+		{name: "no go.mod", modify: func(m *Manifest) { m.Files = []string{"cmd/main.go.tmpl"} }, want: "go.mod.tmpl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := validManifest()
+			tt.modify(&manifest)
+
+			pack, err := Load(writePack(t, manifest, "go.mod.tmpl", "cmd/main.go.tmpl"))
+			if tt.want == "" {
+				if err != nil || pack.Name != manifest.Name {
+					t.Fatalf("Load() = %v, %v; want the pack", pack, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Load() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsInvalidJSON(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ManifestFile), []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(dir); err == nil || !strings.Contains(err.Error(), "failed to parse") {
+		t.Errorf("Load() error = %v, want a parse error", err)
+	}
+}
+
+// tarEntry is a file written into a test archive.
+type tarEntry struct {
+	name     string
+	content  string
+	typeflag byte
+}
+
+// writeArchive writes entries into a .tar.gz file and returns its path.
+func writeArchive(t *testing.T, entries ...tarEntry) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, entry := range entries {
+		typeflag := entry.typeflag
+		if typeflag == 0 {
+			typeflag = tar.TypeReg
+		}
+		header := &tar.Header{Name: entry.name, Mode: 0600, Size: int64(len(entry.content)), Typeflag: typeflag}
+		if typeflag != tar.TypeReg {
+			header.Size = 0
+			header.Linkname = "/etc/passwd"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if typeflag == tar.TypeReg {
+			if _, err := tw.Write([]byte(entry.content)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "pack.tar.gz")
+	if err := os.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestExtractArchiveRejectsTraversal(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry string
+	}{
+		{name: "parent", entry: "../escape.txt"},
+		{name: "nested parent", entry: "pack/../../escape.txt"},
+		{name: "absolute", entry: "/tmp/escape.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			dest := filepath.Join(root, "dest")
+
+			err := extractArchive(writeArchive(t, tarEntry{name: tt.entry, content: "x"}), dest)
+			if err == nil || !strings.Contains(err.Error(), "outside the pack") {
+				t.Fatalf("extractArchive() error = %v, want the entry rejected", err)
+			}
+			if _, err := os.Stat(filepath.Join(root, "escape.txt")); err == nil {
+				t.Error("the entry was written outside dest")
+			}
+		})
+	}
+}
+
+func TestExtractArchiveSkipsLinks(t *testing.T) {
+	dest := t.TempDir()
+	archive := writeArchive(t,
+		tarEntry{name: "pack/go.mod.tmpl", content: "module x\n"},
+		tarEntry{name: "pack/passwd", typeflag: tar.TypeSymlink},
+	)
+
+	if err := extractArchive(archive, dest); err != nil {
+		t.Fatalf("extractArchive() error = %v", err)
+	}
+	if _, err := os.Lstat(filepath.Join(dest, "pack", "passwd")); err == nil {
+		t.Error("symlink entry was extracted")
+	}
+	if content, err := os.ReadFile(filepath.Join(dest, "pack", "go.mod.tmpl")); err != nil || string(content) != "module x\n" {
+		t.Errorf("go.mod.tmpl = %q, %v", content, err)
+	}
+}
+
+func TestInstallArchive(t *testing.T) {
+	manifest, err := json.Marshal(validManifest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive := writeArchive(t,
+		tarEntry{name: "event-consumer/" + ManifestFile, content: string(manifest)},
+		tarEntry{name: "event-consumer/go.mod.tmpl", content: "module {{.ModuleName}}\n"},
+		tarEntry{name: "event-consumer/cmd/main.go.tmpl", content: "package main\n"},
+	)
+	destRoot := t.TempDir()
+
+	pack, err := Install(archive, destRoot, false)
+	if err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	if pack.Dir != filepath.Join(destRoot, "event-consumer") {
+		t.Errorf("Install() dir = %s", pack.Dir)
+	}
+
+	if _, err := Install(archive, destRoot, false); err == nil || !strings.Contains(err.Error(), "already installed") {
+		t.Errorf("second Install() error = %v, want already installed", err)
+	}
+	if _, err := Install(archive, destRoot, true); err != nil {
+		t.Errorf("Install() with force error = %v", err)
+	}
+}
+
+func TestInstallRejectsOverlappingSource(t *testing.T) {
+	destRoot := t.TempDir()
+	pack, err := Install(writePack(t, validManifest(), validManifest().Files...), destRoot, false)
+	if err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+
+	link := filepath.Join(t.TempDir(), "link")
+	if err := os.Symlink(destRoot, link); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		source   string
+		destRoot string
+	}{
+		{name: "installed pack", source: pack.Dir, destRoot: destRoot},
+		{name: "installed pack through a symlink", source: filepath.Join(link, pack.Name), destRoot: destRoot},
+		{name: "path with dot segments", source: filepath.Join(destRoot, "..", filepath.Base(destRoot), pack.Name), destRoot: destRoot},
+		{name: "install into the source", source: pack.Dir, destRoot: filepath.Join(pack.Dir, "packs")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Install(tt.source, tt.destRoot, true); err == nil || !strings.Contains(err.Error(), "overlaps the install directory") {
+				t.Fatalf("Install() error = %v, want overlaps", err)
+			}
+			if _, err := os.Stat(filepath.Join(pack.Dir, "go.mod.tmpl")); err != nil {
+				t.Errorf("source was modified: %v", err)
+			}
+		})
+	}
+}
@@ -2,58 +2,98 @@ package scaffold
 
 import (
 	"embed"
-	"fmt"
-	"os"
+	"io/fs"
+	"path"
 	"path/filepath"
-	"text/template"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/templates"
 )
 
 //go:embed all:*
 var templatesFS embed.FS
 
+// Templates returns the embedded schematic templates, rooted at the templates directory.
+func Templates() fs.FS {
+	sub, err := fs.Sub(templatesFS, "templates")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
 // TemplateData adalah struktur data universal yang dikirim ke semua template.
+// It embeds the project settings (the same model project templates are rendered with),
+// so .ModuleName, .DatabaseDriver, .Framework, .TestingFramework and hasAddon work everywhere.
 type TemplateData struct {
-	PackageName string
-	StructName  string
-	ModuleName  string
+	core.ProjectConfig
+	PackageName   string
+	StructName    string
+	TableName     string
+	Fields        []Field
+	Queries       SQLQueries
+	HasRepository bool
+	Kind          string
+	Schedule      string
+	Jobs          []string
+	FileName      string
+	Services      []TemplateData
+	ConfigFields  []ConfigField
+	Source        string
 }
 
-// renderFile adalah fungsi generic untuk menulis file dari template embed.
-func renderFile(templatePath string, targetPath string, data TemplateData) error {
-	fullPath := "templates/" + templatePath
-
-	tplContent, err := templatesFS.ReadFile(fullPath)
-	if err != nil {
-		return fmt.Errorf("template not found in embed fs: %s", fullPath)
+// IDType returns the Go type of entity IDs: ObjectID hex strings for MongoDB, int64 otherwise.
+func (d TemplateData) IDType() string {
+	if d.DatabaseDriver() == "MongoDB" {
+		return "string"
 	}
+	return "int64"
+}
 
-	tmpl, err := template.New(templatePath).Parse(string(tplContent))
-	if err != nil {
-		return fmt.Errorf("failed to parse template %s: %w", templatePath, err)
-	}
+// Import returns the import path of an architecture layer package, e.g. "github.com/user/app/models".
+func (d TemplateData) Import(layer string) string {
+	return path.Join(d.ModuleName, filepath.ToSlash(layerDir(d.ProjectScale, layer)))
+}
 
-	cleanPath := filepath.Clean(targetPath)
+// ModuleVersion returns the version pinned in the dependency registry for a module, e.g. "v1.36.12"
+// for "google.golang.org/protobuf", so tools installed by generated Makefiles match the project's go.mod.
+func (d TemplateData) ModuleVersion(module string) string {
+	return core.PinnedVersions()[module]
+}
 
-	dir := filepath.Dir(cleanPath)
-	if err := os.MkdirAll(dir, 0750); err != nil {
-		return fmt.Errorf("failed to create directory %s: %w", dir, err)
-	}
+// ProtoImport returns the Go import path of the code generated from FileName's .proto file.
+func (d TemplateData) ProtoImport() string {
+	return path.Join(d.ModuleName, filepath.ToSlash(protoDir(d.FileName)))
+}
 
-	f, err := os.Create(cleanPath)
-	if err != nil {
-		return fmt.Errorf("failed to create file %s: %w", cleanPath, err)
+// ProtoGoPackage returns the Go package name of the generated protobuf code, e.g. "orderitemv1".
+func (d TemplateData) ProtoGoPackage() string {
+	return strings.ReplaceAll(d.FileName, "_", "") + "v1"
+}
+
+// layerDir returns the folder of an architecture layer (handlers, models, ...).
+// Small projects keep layers at the root, larger scales nest them under internal/.
+func layerDir(scale, layer string) string {
+	if scale == "Small" {
+		return layer
 	}
+	return filepath.Join("internal", layer)
+}
 
-	defer func() {
-		if closeErr := f.Close(); closeErr != nil {
-			fmt.Printf("Warning: failed to close file %s: %v\n", cleanPath, closeErr)
-		}
-	}()
+// projectEngine returns the shared template engine, writing into the current project.
+func projectEngine() *templates.Engine {
+	return templates.NewEngine(".", Templates())
+}
 
-	// 7. Eksekusi Template ke File
-	if err := tmpl.Execute(f, data); err != nil {
-		return fmt.Errorf("failed to execute template: %w", err)
-	}
+// templateFile pairs a schematic template with the file it renders to.
+// Generators keep them in a slice so files are written and reported in a stable order.
+type templateFile struct {
+	template string
+	output   string
+}
 
-	return nil
+// renderFile adalah fungsi generic untuk menulis file dari template embed, relative to the project root.
+func renderFile(templatePath string, targetPath string, data TemplateData) error {
+	return projectEngine().RenderFile(templatePath, targetPath, data)
 }
@@ -0,0 +1,200 @@
+package scaffold
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// Field is a single typed attribute parsed from a command line spec such as "bio:text:optional".
+type Field struct {
+	// Name is the exported Go identifier, e.g. "CreatedAt".
+	Name string
+	// Column is the snake_case column and JSON key, e.g. "created_at".
+	Column string
+	// Type is the normalized spec type, e.g. "string", "int", "time".
+	Type string
+	// GoType is the Go type used in structs, e.g. "time.Time".
+	GoType string
+	// Optional makes the field nullable and drops the "required" validation.
+	Optional bool
+}
+
+// fieldTypes maps accepted spec types (and aliases) to their normalized type and Go type.
+var fieldTypes = map[string][2]string{
+	"string":    {"string", "string"},
+	"text":      {"text", "string"},
+	"uuid":      {"uuid", "string"},
+	"int":       {"int", "int"},
+	"integer":   {"int", "int"},
+	"int64":     {"int64", "int64"},
+	"bigint":    {"int64", "int64"},
+	"float":     {"float", "float64"},
+	"float64":   {"float", "float64"},
+	"decimal":   {"float", "float64"},
+	"bool":      {"bool", "bool"},
+	"boolean":   {"bool", "bool"},
+	"time":      {"time", "time.Time"},
+	"datetime":  {"time", "time.Time"},
+	"timestamp": {"time", "time.Time"},
+	"date":      {"time", "time.Time"},
+}
+
+// ParseFields converts specs of the form "name:type[:modifier...]" into fields.
+// The only supported modifier is "optional" (alias "null").
+func ParseFields(specs []string) ([]Field, error) {
+	fields := make([]Field, 0, len(specs))
+	seen := make(map[string]bool)
+
+	for _, spec := range specs {
+		parts := strings.Split(spec, ":")
+		column := naming.Snake(parts[0])
+		if column == "" || (column[0] >= '0' && column[0] <= '9') {
+			return nil, fmt.Errorf("invalid field spec '%s': a field name must start with a letter", spec)
+		}
+		if column == "id" {
+			return nil, fmt.Errorf("invalid field spec '%s': the id field is generated automatically", spec)
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("invalid field spec '%s': duplicate field '%s'", spec, column)
+		}
+		seen[column] = true
+
+		typeName := "string"
+		if len(parts) > 1 && parts[1] != "" {
+			typeName = strings.ToLower(parts[1])
+		}
+
+		types, ok := fieldTypes[typeName]
+		if !ok {
+			return nil, fmt.Errorf("invalid field spec '%s': unknown type '%s'", spec, typeName)
+		}
+
+		field := Field{
+			Name:   naming.Pascal(column),
+			Column: column,
+			Type:   types[0],
+			GoType: types[1],
+		}
+
+		for _, modifier := range parts[min(len(parts), 2):] {
+			switch strings.ToLower(modifier) {
+			case "optional", "null":
+				field.Optional = true
+			default:
+				return nil, fmt.Errorf("invalid field spec '%s': unknown modifier '%s'", spec, modifier)
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// Validation returns the go-playground/validator rules for create payloads.
+func (f Field) Validation() string {
+	rules := []string{"omitempty"}
+	if !f.Optional && f.Type != "bool" {
+		// "required" rejects false, so booleans are never required
+		rules = []string{"required"}
+	}
+
+	return strings.Join(append(rules, f.formatRules()...), ",")
+}
+
+// UpdateValidation returns the validator rules for partial update payloads, where every field may be omitted.
+func (f Field) UpdateValidation() string {
+	return strings.Join(append([]string{"omitempty"}, f.formatRules()...), ",")
+}
+
+// formatRules returns the type specific validator rules for the field.
+func (f Field) formatRules() []string {
+	switch {
+	case f.Type == "uuid":
+		return []string{"uuid"}
+	case f.Type == "string" && strings.Contains(f.Column, "email"):
+		return []string{"email"}
+	case f.Type == "string":
+		return []string{"max=255"}
+	}
+	return nil
+}
+
+// SQLiteType returns the SQLite column type of the field, used by the in-memory schema of repository tests.
+func (f Field) SQLiteType() string {
+	switch f.Type {
+	case "int", "int64":
+		return "INTEGER"
+	case "float":
+		return "REAL"
+	case "bool":
+		return "BOOLEAN"
+	case "time":
+		return "DATETIME"
+	default:
+		return "TEXT"
+	}
+}
+
+// SampleValue returns a Go expression producing a valid value for tests.
+func (f Field) SampleValue() string {
+	value := f.SampleLiteral()
+	if f.Optional {
+		return "func() *" + f.GoType + " { v := " + f.GoType + "(" + value + "); return &v }()"
+	}
+	return value
+}
+
+// SampleLiteral returns the untyped sample used by SampleValue, without the pointer wrapping of optional fields.
+func (f Field) SampleLiteral() string {
+	var value string
+	switch f.Type {
+	case "uuid":
+		value = `"3f2504e0-4f89-11d3-9a0c-0305e82c3301"`
+	case "int", "int64":
+		value = "42"
+	case "float":
+		value = "4.2"
+	case "bool":
+		value = "true"
+	case "time":
+		value = "time.Now().UTC().Truncate(time.Second)"
+	default:
+		value = `"sample ` + strings.ReplaceAll(f.Column, "_", " ") + `"`
+		if strings.Contains(f.Column, "email") {
+			value = `"user@example.com"`
+		}
+	}
+	return value
+}
+
+// NeedsTime reports whether any field requires the "time" import.
+func (d TemplateData) NeedsTime() bool {
+	for _, f := range d.Fields {
+		if f.GoType == "time.Time" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasOptional reports whether any field is optional (a pointer).
+func (d TemplateData) HasOptional() bool {
+	for _, f := range d.Fields {
+		if f.Optional {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTag returns the struct tag key used for validation rules.
+// Gin validates "binding" tags in ShouldBindJSON, other frameworks use go-playground/validator directly.
+func (d TemplateData) ValidateTag() string {
+	if d.Framework() == "Gin" {
+		return "binding"
+	}
+	return "validate"
+}
@@ -0,0 +1,290 @@
+package scaffold
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// ConfigField is a single key of the env template, typed by its example value.
+type ConfigField struct {
+	// Key is the environment variable, e.g. "DB_CONN_MAX_LIFETIME".
+	Key string
+	// Name is the Go field name, e.g. "DBConnMaxLifetime".
+	Name string
+	// GoType is the field type, e.g. "time.Duration".
+	GoType string
+	// Method is the parser method reading the value, e.g. "Duration".
+	Method string
+	// Default is the example value used when the key is not set.
+	Default string
+	// Required fails loading when the key is missing.
+	Required bool
+	// Comments are the comment lines written above the key.
+	Comments []string
+	// Section is set on the first key below a "# ===" section header.
+	Section string
+}
+
+var (
+	intValuePattern      = regexp.MustCompile(`^-?\d+$`)
+	floatValuePattern    = regexp.MustCompile(`^-?\d+\.\d+$`)
+	durationValuePattern = regexp.MustCompile(`^(\d+(\.\d+)?(ns|us|µs|ms|s|m|h))+$`)
+	sizeValuePattern     = regexp.MustCompile(`(?i)^\d+(\.\d+)?\s*(b|k|kb|kib|m|mb|mib|g|gb|gib|t|tb|tib)$`)
+	envKeyPattern        = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+	requiredPattern      = regexp.MustCompile(`(?i)\brequired\b`)
+	// secretKeyPattern marks keys whose example value must never become a compiled-in default.
+	secretKeyPattern = regexp.MustCompile(`SECRET|PASSWORD|TOKEN|_KEY`)
+)
+
+// defaultConfigSource is the env template read when no source is given.
+const defaultConfigSource = ".env.example"
+
+// GenerateConfig generates a typed Config loader from the project's env template.
+// The source is opts.From, defaulting to .env.example; a short name such as "production" reads .env.production.
+func GenerateConfig(meta *core.ProjectMetadata, opts Options) error {
+	path, err := configSource(opts.From)
+	if err != nil {
+		return err
+	}
+
+	fields, err := parseEnvTemplate(path)
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("no keys found in %s", path)
+	}
+
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		PackageName:   "config",
+		ConfigFields:  fields,
+		Source:        filepath.Base(path),
+	}
+
+	targetDir := layerDir(meta.ProjectScale, "config")
+	files := []templateFile{{"config.tmpl", "config.go"}}
+	if opts.WithTests {
+		files = append(files, templateFile{testTemplate(meta, "config_test.tmpl"), "config_test.go"})
+	}
+
+	for _, file := range files {
+		templatePath := filepath.Join("common", "config", file.template)
+		targetPath := filepath.Join(targetDir, file.output)
+
+		if err := renderFile(templatePath, targetPath, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("   Created config: %s\n", targetPath)
+	}
+
+	if opts.WithTests {
+		if err := prepareTests(meta, targetDir, data.PackageName); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("   Parsed %d keys from %s\n", len(fields), path)
+	return nil
+}
+
+// configSource returns the env file to read for source: the file itself, or ".env.<source>".
+func configSource(source string) (string, error) {
+	if source == "" {
+		source = defaultConfigSource
+	}
+
+	candidates := []string{source}
+	if short := ".env." + strings.TrimPrefix(source, ".env."); short != source && !strings.ContainsAny(source, `/\`) {
+		candidates = append(candidates, short)
+	}
+
+	for _, path := range candidates {
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("env template not found, looked for %s; pass another file with --from", strings.Join(candidates, " and "))
+}
+
+// parseEnvTemplate reads an env file and infers the type of every key from its value.
+// Comment lines above a key become its doc comment; a comment containing "required" marks it as required.
+func parseEnvTemplate(path string) ([]ConfigField, error) {
+	// #nosec G304 -- The env template is chosen by the user inside their own project.
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open env template %s: %w", path, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var fields []ConfigField
+	var comments []string
+	section, inHeader := "", false
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			comments = nil
+			continue
+		case strings.HasPrefix(line, "# ==="):
+			inHeader = !inHeader
+			comments = nil
+			continue
+		case strings.HasPrefix(line, "#"):
+			text := strings.TrimSpace(strings.TrimPrefix(line, "#"))
+			if inHeader {
+				section = text
+			} else if text != "" {
+				comments = append(comments, text)
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		key = strings.TrimSpace(key)
+		if !ok || !envKeyPattern.MatchString(key) || seen[key] {
+			comments = nil
+			continue
+		}
+		seen[key] = true
+
+		value, inline := splitEnvValue(value)
+		if inline != "" {
+			comments = append(comments, inline)
+		}
+
+		field := newConfigField(key, value, comments)
+		field.Section = section
+		section = ""
+
+		fields = append(fields, field)
+		comments = nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return fields, nil
+}
+
+// newConfigField types a key by its example value.
+func newConfigField(key, value string, comments []string) ConfigField {
+	field := ConfigField{
+		Key:      key,
+		Name:     naming.Pascal(key),
+		Default:  value,
+		Comments: comments,
+		Required: requiredPattern.MatchString(strings.Join(comments, " ")),
+	}
+
+	switch lower := strings.ToLower(value); {
+	case lower == "true" || lower == "false":
+		field.GoType, field.Method = "bool", "Bool"
+	case intValuePattern.MatchString(value):
+		field.GoType, field.Method = "int", "Int"
+	case floatValuePattern.MatchString(value):
+		field.GoType, field.Method = "float64", "Float"
+	case durationValuePattern.MatchString(value):
+		field.GoType, field.Method = "time.Duration", "Duration"
+	case sizeValuePattern.MatchString(value):
+		field.GoType, field.Method = "int64", "Size"
+		field.Comments = append(field.Comments, "Size in bytes.")
+	default:
+		field.GoType, field.Method = "string", "String"
+	}
+
+	if field.Required || secretKeyPattern.MatchString(key) {
+		field.Default = ""
+	}
+	return field
+}
+
+// splitEnvValue strips quotes from a raw value and returns an inline comment separately.
+func splitEnvValue(value string) (string, string) {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') {
+		if end := strings.IndexByte(value[1:], value[0]); end >= 0 {
+			return value[1 : end+1], ""
+		}
+	}
+
+	if i := strings.Index(value, "#"); i >= 0 && (i == 0 || value[i-1] == ' ') {
+		return strings.TrimSpace(value[:i]), strings.TrimSpace(value[i+1:])
+	}
+	return value, ""
+}
+
+// Sample returns a valid value of the field for generated tests.
+func (f ConfigField) Sample() string {
+	if f.Default != "" {
+		return f.Default
+	}
+
+	switch f.Method {
+	case "Bool":
+		return "true"
+	case "Int", "Float":
+		return "1"
+	case "Duration":
+		return "1s"
+	case "Size":
+		return "1MB"
+	default:
+		return "sample"
+	}
+}
+
+// NeedsDuration reports whether the config uses time.Duration.
+func (d TemplateData) NeedsDuration() bool {
+	for _, f := range d.ConfigFields {
+		if f.Method == "Duration" {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiredKey returns the first required config key, used by generated tests.
+func (d TemplateData) RequiredKey() string {
+	for _, f := range d.ConfigFields {
+		if f.Required {
+			return f.Key
+		}
+	}
+	return ""
+}
+
+// sampleField returns the first optional string key, used by generated tests.
+func (d TemplateData) sampleField() ConfigField {
+	for _, f := range d.ConfigFields {
+		if f.Method == "String" && !f.Required {
+			return f
+		}
+	}
+	return ConfigField{}
+}
+
+// SampleKey returns the env key of sampleField.
+func (d TemplateData) SampleKey() string {
+	return d.sampleField().Key
+}
+
+// SampleField returns the Go field name of sampleField.
+func (d TemplateData) SampleField() string {
+	return d.sampleField().Name
+}
@@ -0,0 +1,130 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/robfig/cron/v3"
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// defaultSchedule is used when no schedule expression is given.
+const defaultSchedule = "@hourly"
+
+// jobConstructorPattern finds the constructors of generated jobs, e.g. "func NewCleanupJob()".
+var jobConstructorPattern = regexp.MustCompile(`(?m)^func New(\w+)Job\(\)`)
+
+// GenerateCronJob generates a scheduled job and registers it with the scheduler.
+// The scheduler bootstrap is created the first time a job is generated.
+func GenerateCronJob(meta *core.ProjectMetadata, name string, args []string, opts Options) error {
+	fileName := naming.Snake(name)
+	if fileName == "" {
+		return fmt.Errorf("invalid job name '%s'", name)
+	}
+
+	schedule := strings.TrimSpace(strings.Join(args, " "))
+	if schedule == "" {
+		schedule = defaultSchedule
+	}
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		return fmt.Errorf("invalid schedule '%s': %w", schedule, err)
+	}
+
+	targetDir := layerDir(meta.ProjectScale, "jobs")
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		PackageName:   "jobs",
+		StructName:    naming.Pascal(fileName),
+		Schedule:      schedule,
+	}
+
+	files := []templateFile{{"job.tmpl", fileName + "_job.go"}}
+	if opts.WithTests {
+		files = append(files, templateFile{testTemplate(meta, "job_test.tmpl"), fileName + "_job_test.go"})
+	}
+
+	// Bootstrap the scheduler on the first job
+	bootstrap := false
+	if _, err := os.Stat(filepath.Join(targetDir, "scheduler.go")); os.IsNotExist(err) {
+		bootstrap = true
+		files = append(files, templateFile{"scheduler.tmpl", "scheduler.go"})
+		if opts.WithTests {
+			files = append(files, templateFile{testTemplate(meta, "scheduler_test.tmpl"), "scheduler_test.go"})
+		}
+	}
+
+	for _, file := range files {
+		templatePath := filepath.Join("common", "jobs", file.template)
+		targetPath := filepath.Join(targetDir, file.output)
+
+		if err := renderFile(templatePath, targetPath, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("   Created job: %s\n", targetPath)
+	}
+
+	if err := prepareLogger(meta); err != nil {
+		return err
+	}
+
+	if opts.WithTests {
+		if err := prepareTests(meta, targetDir, data.PackageName); err != nil {
+			return err
+		}
+	}
+
+	if err := writeJobRegistry(targetDir, data); err != nil {
+		return err
+	}
+
+	if bootstrap {
+		if err := installPackages(core.GetPackages("Cron")...); err != nil {
+			fmt.Printf("   ⚠️  Could not install the cron parser: %v\n", err)
+		}
+
+		fmt.Println("   Start the scheduler in main.go:")
+		fmt.Println("     scheduler := jobs.NewScheduler(nil)")
+		fmt.Println("     if err := jobs.Register(scheduler); err != nil { ... }")
+		fmt.Println("     scheduler.Start(ctx)")
+		fmt.Println("     defer scheduler.Stop(shutdownCtx)")
+	}
+
+	return nil
+}
+
+// writeJobRegistry rewrites registry.go so Register adds every job found in the jobs folder.
+func writeJobRegistry(dir string, data TemplateData) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*_job.go"))
+	if err != nil {
+		return fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	var jobs []string
+	for _, path := range paths {
+		// #nosec G304 -- Paths come from a glob inside the project's jobs folder.
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		for _, match := range jobConstructorPattern.FindAllStringSubmatch(string(content), -1) {
+			jobs = append(jobs, match[1])
+		}
+	}
+	sort.Strings(jobs)
+
+	data.Jobs = jobs
+	targetPath := filepath.Join(dir, "registry.go")
+	if err := renderFile(filepath.Join("common", "jobs", "registry.tmpl"), targetPath, data); err != nil {
+		return err
+	}
+
+	fmt.Printf("   Registered %d job(s) in %s\n", len(jobs), targetPath)
+	return nil
+}
@@ -7,14 +7,15 @@ import (
 	"strings"
 
 	"github.com/xRiot45/gocrafting/internal/core"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/xRiot45/gocrafting/internal/naming"
 )
 
 // GenerateHandler generates a handler file based on project scale and framework.
-func GenerateHandler(meta *core.ProjectMetadata, name string) error {
-	lowerName := strings.ToLower(name)
-	titleName := cases.Title(language.English).String(lowerName)
+func GenerateHandler(meta *core.ProjectMetadata, name string, opts Options) error {
+	fileName := naming.Snake(name)
+	if fileName == "" {
+		return fmt.Errorf("invalid handler name '%s'", name)
+	}
 
 	scaleFolder := strings.ToLower(meta.ProjectScale)
 
@@ -22,40 +23,53 @@ func GenerateHandler(meta *core.ProjectMetadata, name string) error {
 		return fmt.Errorf("project scale '%s' is not supported", meta.ProjectScale)
 	}
 
-	var templateFilename string
+	var templateName string
 	if meta.SelectedTemplate == "Simple API" {
-		templateFilename = "net_http.tmpl"
+		templateName = "net_http"
 	} else {
 		switch meta.SelectedFramework {
 		case "Fiber":
-			templateFilename = "fiber.tmpl"
+			templateName = "fiber"
 		case "Gin":
-			templateFilename = "gin.tmpl"
+			templateName = "gin"
 		default:
 			return fmt.Errorf("framework '%s' not supported for handler generation", meta.SelectedFramework)
 		}
 	}
 
-	templatePath := filepath.Join(scaleFolder, "handlers", templateFilename)
+	targetDir := layerDir(meta.ProjectScale, "handlers")
 
-	targetDir := "internal/handlers"
-	if meta.ProjectScale == "Small" {
-		targetDir = "handlers"
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		PackageName:   "handlers",
+		StructName:    naming.Pascal(fileName),
 	}
 
-	fileName := fmt.Sprintf("%s_handler.go", lowerName)
-	targetPath := filepath.Clean(filepath.Join(targetDir, fileName))
+	files := []templateFile{{templateName + ".tmpl", fileName + "_handler.go"}}
+	if opts.WithTests {
+		files = append(files, templateFile{testTemplate(meta, templateName+"_test.tmpl"), fileName + "_handler_test.go"})
+	}
 
-	data := TemplateData{
-		PackageName: "handlers",
-		StructName:  titleName,
-		ModuleName:  meta.ModuleName,
+	for _, file := range files {
+		templatePath := filepath.Join(scaleFolder, "handlers", file.template)
+		targetPath := filepath.Clean(filepath.Join(targetDir, file.output))
+
+		if err := renderFile(templatePath, targetPath, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("   Created handler: %s\n", targetPath)
 	}
 
-	if err := renderFile(templatePath, targetPath, data); err != nil {
+	if err := prepareLogger(meta); err != nil {
 		return err
 	}
 
-	fmt.Printf("   Created handler: %s\n", targetPath)
+	if opts.WithTests {
+		if err := prepareTests(meta, targetDir, data.PackageName); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
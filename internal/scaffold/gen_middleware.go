@@ -0,0 +1,255 @@
+package scaffold
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// middlewareKinds maps accepted kind names (and aliases) to the built-in middleware kinds.
+var middlewareKinds = map[string]string{
+	"request_id": "request_id",
+	"requestid":  "request_id",
+	"logging":    "logging",
+	"logger":     "logging",
+	"recovery":   "recovery",
+	"recover":    "recovery",
+	"cors":       "cors",
+	"rate_limit": "rate_limit",
+	"ratelimit":  "rate_limit",
+	"limiter":    "rate_limit",
+	"auth":       "auth",
+}
+
+// routerFiles are the files searched, in order, for the router setup when registering middleware.
+var routerFiles = []string{"main.go", filepath.Join("cmd", "server", "main.go"), filepath.Join("internal", "router", "router.go")}
+
+var (
+	// serverHandlerPattern matches the Handler field of an http.Server literal.
+	serverHandlerPattern = regexp.MustCompile(`(?m)^(\s*Handler:\s*)(.+?),\s*$`)
+	// wrapperPattern matches a middleware call wrapping the server handler, e.g. "middleware.CORS(".
+	wrapperPattern = regexp.MustCompile(`^middleware\.\w+\(`)
+	// routerPattern matches the creation of a Gin engine or Fiber app, e.g. "r := gin.Default()".
+	routerPattern = regexp.MustCompile(`^\s*(\w+)\s*:?=\s*(gin\.Default|gin\.New|fiber\.New)\(.*\)\s*$`)
+)
+
+// GenerateMiddleware generates a middleware for the project's framework.
+// The kind is taken from args[0] or, when omitted, from the name itself (e.g. "cors");
+// unknown kinds produce an empty skeleton. With opts.Register set, the middleware is added to the router.
+func GenerateMiddleware(meta *core.ProjectMetadata, name string, args []string, opts Options) error {
+	fileName := naming.Snake(name)
+	if fileName == "" {
+		return fmt.Errorf("invalid middleware name '%s'", name)
+	}
+
+	kind := middlewareKinds[fileName]
+	if len(args) > 0 {
+		var ok bool
+		if kind, ok = middlewareKinds[naming.Snake(args[0])]; !ok {
+			return fmt.Errorf("unknown middleware kind '%s'. Available kinds: request_id, logging, recovery, cors, rate_limit, auth", args[0])
+		}
+	}
+
+	templateFilename, err := middlewareTemplate(meta)
+	if err != nil {
+		return err
+	}
+
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		PackageName:   "middleware",
+		StructName:    naming.Pascal(fileName),
+		Kind:          kind,
+	}
+
+	targetDir := layerDir(meta.ProjectScale, "middleware")
+	targetPath := filepath.Join(targetDir, fileName+".go")
+
+	if err := renderFile(filepath.Join("common", "middleware", templateFilename), targetPath, data); err != nil {
+		return err
+	}
+
+	if kind == "" {
+		fmt.Printf("   Created middleware: %s\n", targetPath)
+	} else {
+		fmt.Printf("   Created middleware: %s (%s)\n", targetPath, kind)
+	}
+
+	if kind == "logging" || kind == "recovery" {
+		if err := prepareLogger(meta); err != nil {
+			return err
+		}
+	}
+
+	if opts.WithTests {
+		testPath := filepath.Join(targetDir, fileName+"_test.go")
+		testTemplatePath := filepath.Join("common", "middleware", testTemplate(meta, strings.TrimSuffix(templateFilename, ".tmpl")+"_test.tmpl"))
+
+		if err := renderFile(testTemplatePath, testPath, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("   Created middleware: %s\n", testPath)
+
+		if err := prepareTests(meta, targetDir, data.PackageName); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Register {
+		return nil
+	}
+
+	return registerMiddleware(templateFilename, data)
+}
+
+// middlewareTemplate returns the middleware template matching the project's framework.
+func middlewareTemplate(meta *core.ProjectMetadata) (string, error) {
+	if meta.SelectedTemplate == "Simple API" {
+		return "net_http.tmpl", nil
+	}
+
+	switch meta.SelectedFramework {
+	case "Fiber":
+		return "fiber.tmpl", nil
+	case "Gin":
+		return "gin.tmpl", nil
+	default:
+		return "", fmt.Errorf("framework '%s' not supported for middleware generation", meta.SelectedFramework)
+	}
+}
+
+// registerMiddleware adds the middleware to the router setup and imports the middleware package.
+// net/http handlers are wrapped in the http.Server literal, Gin and Fiber get a Use call after the router is created.
+func registerMiddleware(templateFilename string, data TemplateData) error {
+	routerFile := ""
+	for _, candidate := range routerFiles {
+		if _, err := os.Stat(candidate); err == nil {
+			routerFile = candidate
+			break
+		}
+	}
+	if routerFile == "" {
+		return fmt.Errorf("router setup not found (looked in %s), register %s manually", strings.Join(routerFiles, ", "), data.StructName)
+	}
+
+	// #nosec G304 -- The router file is one of the fixed candidates inside the project.
+	content, err := os.ReadFile(routerFile)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", routerFile, err)
+	}
+
+	source := string(content)
+	call := data.PackageName + "." + data.StructName
+	if strings.Contains(source, call+"(") {
+		fmt.Printf("   %s is already registered in %s\n", call, routerFile)
+		return nil
+	}
+
+	if templateFilename == "net_http.tmpl" {
+		match := serverHandlerPattern.FindStringSubmatchIndex(source)
+		if match == nil {
+			return fmt.Errorf("http.Server Handler field not found in %s, register %s manually", routerFile, call)
+		}
+		// Wrap the innermost handler so middleware runs in the order it was registered, like Use does
+		handler := source[match[4]:match[5]]
+		wrappers := ""
+		for {
+			wrapper := wrapperPattern.FindString(handler)
+			if wrapper == "" || !strings.HasSuffix(handler, ")") {
+				break
+			}
+			wrappers += wrapper
+			handler = handler[len(wrapper) : len(handler)-1]
+		}
+		wrapped := wrappers + call + "(" + handler + ")" + strings.Repeat(")", strings.Count(wrappers, "("))
+		source = source[:match[4]] + wrapped + source[match[5]:]
+	} else {
+		source, err = insertUseCall(source, call+"()")
+		if err != nil {
+			return fmt.Errorf("%w in %s, register %s manually", err, routerFile, call)
+		}
+	}
+
+	source, err = addImport(source, data.Import("middleware"))
+	if err != nil {
+		return fmt.Errorf("failed to update imports of %s: %w", routerFile, err)
+	}
+
+	if err := projectEngine().WriteFile(routerFile, []byte(source)); err != nil {
+		return fmt.Errorf("failed to update %s: %w", routerFile, err)
+	}
+
+	fmt.Printf("   Registered middleware in %s\n", routerFile)
+	return nil
+}
+
+// insertUseCall adds "<router>.Use(call)" after the router creation and any Use calls that follow it,
+// so middleware runs in the order it was registered.
+func insertUseCall(source, call string) (string, error) {
+	lines := strings.Split(source, "\n")
+
+	for i, line := range lines {
+		match := routerPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		at := i + 1
+		for at < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[at]), match[1]+".Use(") {
+			at++
+		}
+
+		use := indent + match[1] + ".Use(" + call + ")"
+		lines = append(lines[:at], append([]string{use}, lines[at:]...)...)
+		return strings.Join(lines, "\n"), nil
+	}
+
+	return "", fmt.Errorf("router creation (gin.Default, gin.New or fiber.New) not found")
+}
+
+// addImport adds importPath as a separate group at the end of the import block when it is missing.
+func addImport(source, importPath string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", source, parser.ImportsOnly)
+	if err != nil {
+		return "", err
+	}
+
+	for _, spec := range file.Imports {
+		if spec.Path.Value == strconv.Quote(importPath) {
+			return source, nil
+		}
+	}
+
+	var imports *ast.GenDecl
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			imports = gen
+		}
+	}
+
+	spec := strconv.Quote(importPath)
+	if imports == nil {
+		// Offsets are positions minus the file base (1)
+		at := int(file.Name.End()) - 1
+		return source[:at] + "\n\nimport " + spec + source[at:], nil
+	}
+	if !imports.Lparen.IsValid() {
+		at := int(imports.End()) - 1
+		return source[:at] + "\nimport " + spec + source[at:], nil
+	}
+
+	// Insert before the closing parenthesis of the last import declaration
+	at := int(imports.Rparen) - 1
+	return source[:at] + "\n\t" + spec + "\n" + source[at:], nil
+}
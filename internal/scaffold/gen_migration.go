@@ -0,0 +1,92 @@
+package scaffold
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/database"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// GenerateMigration creates a timestamped pair of up/down SQL files in the migrations directory.
+// Names such as "create_users_table" produce a CREATE TABLE skeleton in the project's SQL dialect.
+func GenerateMigration(meta *core.ProjectMetadata, name string) error {
+	driver := meta.SelectedDatabaseDriver
+	if driver == "" || driver == "None" {
+		return fmt.Errorf("migrations require a database, but this project was generated without one")
+	}
+
+	fileName := naming.Snake(name)
+	if fileName == "" {
+		return fmt.Errorf("invalid migration name '%s'", name)
+	}
+
+	version, err := migrationVersion()
+	if err != nil {
+		return err
+	}
+
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		StructName:    fileName,
+		TableName:     migrationTableName(fileName),
+	}
+
+	for _, direction := range []string{"up", "down"} {
+		templatePath := filepath.Join("common", "migrations", direction+".sql.tmpl")
+		targetPath := filepath.Join(database.MigrationsDir, fmt.Sprintf("%s_%s.%s.sql", version, fileName, direction))
+
+		if err := renderFile(templatePath, targetPath, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("   Created migration: %s\n", targetPath)
+	}
+
+	return nil
+}
+
+// migrationTableName extracts "users" from "create_users" or "create_users_table".
+// It returns an empty string for any other kind of migration.
+func migrationTableName(name string) string {
+	if !strings.HasPrefix(name, "create_") {
+		return ""
+	}
+
+	table := strings.TrimPrefix(name, "create_")
+	table = strings.TrimSuffix(table, "_table")
+	if table == "" || table == "table" {
+		return ""
+	}
+
+	return table
+}
+
+// migrationVersion returns the timestamp version of a new migration, bumped past the highest
+// existing version so migrations generated within the same second never share one.
+func migrationVersion() (string, error) {
+	migrations, err := database.LoadMigrations(database.MigrationsDir)
+	if err != nil {
+		return "", err
+	}
+
+	version := fileTimestamp()
+	if len(migrations) == 0 {
+		return version, nil
+	}
+
+	latest := migrations[len(migrations)-1].Version
+	if current, err := strconv.ParseInt(version, 10, 64); err == nil && current > latest {
+		return version, nil
+	}
+	return strconv.FormatInt(latest+1, 10), nil
+}
+
+// fileTimestamp returns the UTC version prefix used to order migration and seed files.
+func fileTimestamp() string {
+	return time.Now().UTC().Format("20060102150405")
+}
@@ -0,0 +1,59 @@
+package scaffold
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// GenerateModel generates the entity struct and its request/response DTOs.
+// Field specs follow the "name:type[:modifier...]" format, e.g. "bio:text:optional".
+func GenerateModel(meta *core.ProjectMetadata, name string, fieldSpecs []string, opts Options) error {
+	fields, err := ParseFields(fieldSpecs)
+	if err != nil {
+		return err
+	}
+
+	fileName := naming.Snake(name)
+	if fileName == "" {
+		return fmt.Errorf("invalid model name '%s'", name)
+	}
+
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		PackageName:   "models",
+		StructName:    naming.Pascal(fileName),
+		TableName:     naming.Plural(fileName),
+		Fields:        fields,
+	}
+
+	targetDir := layerDir(meta.ProjectScale, "models")
+	files := []templateFile{
+		{"model.tmpl", fileName + ".go"},
+		{"dto.tmpl", fileName + "_dto.go"},
+	}
+	if opts.WithTests {
+		files = append(files, templateFile{testTemplate(meta, "model_test.tmpl"), fileName + "_test.go"})
+	}
+
+	for _, file := range files {
+		templatePath := filepath.Join("common", "models", file.template)
+		targetPath := filepath.Join(targetDir, file.output)
+
+		if err := renderFile(templatePath, targetPath, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("   Created model: %s\n", targetPath)
+	}
+
+	if opts.WithTests {
+		if err := prepareTests(meta, targetDir, data.PackageName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
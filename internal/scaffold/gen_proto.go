@@ -0,0 +1,195 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/naming"
+	"github.com/xRiot45/gocrafting/internal/shell"
+)
+
+// serverConstructorPattern finds the constructors of generated gRPC servers, e.g. "func NewOrderServer()".
+var serverConstructorPattern = regexp.MustCompile(`(?m)^func New(\w+)Server\(\)`)
+
+// protoDir returns the folder of a service's .proto file and generated code, e.g. "api/proto/order/v1".
+func protoDir(fileName string) string {
+	return filepath.Join("api", "proto", fileName, "v1")
+}
+
+// GenerateProto generates a .proto service definition, a server stub implementing it
+// and the registration code of the project's gRPC server.
+// The server bootstrap, buf configs and Makefile targets are created the first time.
+func GenerateProto(meta *core.ProjectMetadata, name string, opts Options) error {
+	fileName := naming.Snake(name)
+	if fileName == "" || (fileName[0] >= '0' && fileName[0] <= '9') {
+		return fmt.Errorf("invalid service name '%s'", name)
+	}
+
+	targetDir := layerDir(meta.ProjectScale, "rpc")
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		PackageName:   "rpc",
+		StructName:    naming.Pascal(fileName),
+		FileName:      fileName,
+	}
+
+	files := []templateFile{
+		{"service.proto.tmpl", filepath.Join(protoDir(fileName), fileName+".proto")},
+		{"server.tmpl", filepath.Join(targetDir, fileName+"_server.go")},
+	}
+	if opts.WithTests {
+		files = append(files, templateFile{testTemplate(meta, "server_test.tmpl"), filepath.Join(targetDir, fileName+"_server_test.go")})
+	}
+
+	// Bootstrap the gRPC server and the code generation config on the first service
+	bootstrap := false
+	if _, err := os.Stat(filepath.Join(targetDir, "server.go")); os.IsNotExist(err) {
+		bootstrap = true
+		files = append(files, templateFile{"bootstrap.tmpl", filepath.Join(targetDir, "server.go")})
+	}
+	for _, file := range []templateFile{{"buf.yaml.tmpl", "buf.yaml"}, {"buf.gen.yaml.tmpl", "buf.gen.yaml"}} {
+		if _, err := os.Stat(file.output); os.IsNotExist(err) {
+			files = append(files, file)
+		}
+	}
+
+	for _, file := range files {
+		if err := renderFile(filepath.Join("common", "proto", file.template), file.output, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("   Created: %s\n", file.output)
+	}
+
+	if err := writeServiceRegistry(targetDir, data); err != nil {
+		return err
+	}
+
+	if opts.WithTests {
+		if err := prepareTests(meta, targetDir, data.PackageName); err != nil {
+			return err
+		}
+	}
+
+	if err := appendProtoTargets(data); err != nil {
+		return err
+	}
+
+	if bootstrap {
+		if err := installPackages(core.GetPackages("GRPC")...); err != nil {
+			fmt.Printf("   ⚠️  Could not install gRPC packages: %v\n", err)
+		}
+	}
+
+	generateProtoCode()
+
+	if bootstrap {
+		fmt.Println("   Start the gRPC server in main.go:")
+		fmt.Println("     server := rpc.NewServer()")
+		fmt.Println("     go rpc.Serve(server, \":50051\")")
+		fmt.Println("     defer server.GracefulStop()")
+	}
+
+	return nil
+}
+
+// writeServiceRegistry rewrites registry.go so Register adds every server stub found in the rpc folder.
+func writeServiceRegistry(dir string, data TemplateData) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*_server.go"))
+	if err != nil {
+		return fmt.Errorf("failed to list gRPC servers: %w", err)
+	}
+	sort.Strings(paths)
+
+	var services []TemplateData
+	for _, path := range paths {
+		// #nosec G304 -- Paths come from a glob inside the project's rpc folder.
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		match := serverConstructorPattern.FindStringSubmatch(string(content))
+		if match == nil {
+			continue
+		}
+
+		services = append(services, TemplateData{
+			ProjectConfig: data.ProjectConfig,
+			StructName:    match[1],
+			FileName:      strings.TrimSuffix(filepath.Base(path), "_server.go"),
+		})
+	}
+
+	data.Services = services
+	targetPath := filepath.Join(dir, "registry.go")
+	if err := renderFile(filepath.Join("common", "proto", "registry.tmpl"), targetPath, data); err != nil {
+		return err
+	}
+
+	fmt.Printf("   Registered %d service(s) in %s\n", len(services), targetPath)
+	return nil
+}
+
+// appendProtoTargets adds the protobuf generation targets to the project's Makefile once.
+func appendProtoTargets(data TemplateData) error {
+	content, err := os.ReadFile("Makefile")
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read Makefile: %w", err)
+	}
+	if strings.Contains(string(content), "proto-buf:") {
+		return nil
+	}
+
+	targets, _, err := projectEngine().Execute("common/proto/makefile.tmpl", data)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile("Makefile", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open Makefile: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if _, err := file.Write(targets); err != nil {
+		return fmt.Errorf("failed to update Makefile: %w", err)
+	}
+
+	fmt.Println("   Added proto targets to Makefile")
+	return nil
+}
+
+// generateProtoCode runs buf or protoc through the Makefile when one of them is installed.
+func generateProtoCode() {
+	target := ""
+	switch {
+	case commandExists("buf"):
+		target = "proto-buf"
+	case commandExists("protoc"):
+		target = "proto"
+	default:
+		fmt.Println("   ⚠️  Neither buf nor protoc found. Install one, then run 'make proto-tools' and 'make proto'.")
+		return
+	}
+
+	if err := shell.RunMake(".", target); err != nil {
+		fmt.Printf("   ⚠️  Could not generate protobuf code: %v\n", err)
+		return
+	}
+	fmt.Printf("   Generated protobuf code (make %s)\n", target)
+}
+
+// commandExists reports whether the executable is on PATH.
+func commandExists(name string) bool {
+	_, err := exec.LookPath(name)
+	return err == nil
+}
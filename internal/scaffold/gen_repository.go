@@ -0,0 +1,276 @@
+package scaffold
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/database"
+	"github.com/xRiot45/gocrafting/internal/dialect"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// SQLQueries holds the parameterized statements used by a generated database/sql repository.
+type SQLQueries struct {
+	Insert     string
+	SelectByID string
+	SelectAll  string
+	Update     string
+	Delete     string
+}
+
+// GenerateRepository generates a repository interface and an implementation for the project's database.
+// Columns come from the existing model; field specs given for an existing model must match it.
+// A missing model is generated first from the field specs so the repository always compiles.
+func GenerateRepository(meta *core.ProjectMetadata, name string, fieldSpecs []string, opts Options) error {
+	driver := meta.SelectedDatabaseDriver
+	if driver == "" || driver == "None" {
+		return fmt.Errorf("repositories require a database, but this project was generated without one")
+	}
+
+	fileName := naming.Snake(name)
+	if fileName == "" {
+		return fmt.Errorf("invalid repository name '%s'", name)
+	}
+
+	structName := naming.Pascal(fileName)
+	modelPath := filepath.Join(layerDir(meta.ProjectScale, "models"), fileName+".go")
+
+	var fields []Field
+	var err error
+
+	if _, statErr := os.Stat(modelPath); statErr == nil {
+		// The queries must match the struct the repository scans into, so the existing model wins
+		if fields, err = fieldsFromModel(modelPath, structName); err != nil {
+			return err
+		}
+		if len(fieldSpecs) > 0 {
+			if err := matchModelFields(modelPath, fields, fieldSpecs); err != nil {
+				return err
+			}
+			fmt.Printf("   Model %s already exists, keeping it\n", modelPath)
+		}
+	} else {
+		if err = GenerateModel(meta, name, fieldSpecs, opts); err != nil {
+			return err
+		}
+		if fields, err = ParseFields(fieldSpecs); err != nil {
+			return err
+		}
+	}
+
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		PackageName:   "repository",
+		StructName:    structName,
+		TableName:     naming.Plural(fileName),
+		Fields:        fields,
+		Queries:       buildQueries(driver, naming.Plural(fileName), fields),
+	}
+
+	files := []templateFile{{"sql.tmpl", fileName + "_repository.go"}}
+	switch driver {
+	case "MongoDB":
+		files = []templateFile{{"mongo.tmpl", fileName + "_repository.go"}}
+	default:
+		if opts.WithTests {
+			files = append(files, templateFile{testTemplate(meta, "sql_test.tmpl"), fileName + "_repository_test.go"})
+		}
+	}
+
+	targetDir := layerDir(meta.ProjectScale, "repository")
+	for _, file := range files {
+		templatePath := filepath.Join("common", "repository", file.template)
+		targetPath := filepath.Join(targetDir, file.output)
+
+		if err := renderFile(templatePath, targetPath, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("   Created repository: %s\n", targetPath)
+	}
+
+	if opts.WithTests && driver != "MongoDB" {
+		if err := prepareTests(meta, targetDir, data.PackageName); err != nil {
+			return err
+		}
+	}
+
+	// The generated test always runs against in-memory SQLite, whatever the production driver is
+	if opts.WithTests && driver != database.DriverSQLite && driver != "MongoDB" {
+		if err := installPackages(core.GetPackages(database.DriverSQLite)...); err != nil {
+			fmt.Printf("   ⚠️  Could not install the SQLite driver for repository tests: %v\n", err)
+		}
+	}
+
+	return nil
+}
+
+// buildQueries renders the CRUD statements with the placeholder and identifier quoting of the driver.
+func buildQueries(driver, table string, fields []Field) SQLQueries {
+	columns := make([]string, len(fields))
+	values := make([]string, len(fields))
+	assignments := make([]string, len(fields))
+
+	for i, f := range fields {
+		columns[i] = dialect.Quote(driver, f.Column)
+		values[i] = dialect.Placeholder(driver, i+1)
+		assignments[i] = columns[i] + " = " + dialect.Placeholder(driver, i+1)
+	}
+
+	table = dialect.Quote(driver, table)
+	id := dialect.Quote(driver, "id")
+	selectColumns := strings.Join(append([]string{id}, columns...), ", ")
+	next := dialect.Placeholder(driver, len(fields)+1)
+
+	insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, strings.Join(columns, ", "), strings.Join(values, ", "))
+	if len(fields) == 0 {
+		insert = fmt.Sprintf("INSERT INTO %s DEFAULT VALUES", table)
+		if driver == database.DriverMySQL {
+			insert = fmt.Sprintf("INSERT INTO %s () VALUES ()", table)
+		}
+	}
+	if driver == database.DriverPostgreSQL {
+		insert += " RETURNING " + id
+	}
+
+	update := ""
+	if len(fields) > 0 {
+		update = fmt.Sprintf("UPDATE %s SET %s WHERE %s = %s", table, strings.Join(assignments, ", "), id, next)
+	}
+
+	return SQLQueries{
+		Insert:     insert,
+		SelectByID: fmt.Sprintf("SELECT %s FROM %s WHERE %s = %s", selectColumns, table, id, dialect.Placeholder(driver, 1)),
+		SelectAll: fmt.Sprintf("SELECT %s FROM %s ORDER BY %s LIMIT %s OFFSET %s",
+			selectColumns, table, id, dialect.Placeholder(driver, 1), dialect.Placeholder(driver, 2)),
+		Update: update,
+		Delete: fmt.Sprintf("DELETE FROM %s WHERE %s = %s", table, id, dialect.Placeholder(driver, 1)),
+	}
+}
+
+// fieldsFromModel reads the fields of an existing model struct, using its db tags as column names.
+func fieldsFromModel(modelPath, structName string) ([]Field, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), modelPath, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse model %s: %w", modelPath, err)
+	}
+
+	var fields []Field
+	found := false
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.TypeSpec)
+		if !ok || spec.Name.Name != structName {
+			return true
+		}
+
+		structType, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+		found = true
+
+		for _, field := range structType.Fields.List {
+			if len(field.Names) != 1 || field.Names[0].Name == "ID" || field.Tag == nil {
+				continue
+			}
+
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				continue
+			}
+
+			column := strings.Split(reflect.StructTag(tag).Get("db"), ",")[0]
+			if column == "" || column == "-" {
+				continue
+			}
+
+			fields = append(fields, modelField(field.Names[0].Name, column, field.Type))
+		}
+		return false
+	})
+
+	if !found {
+		return nil, fmt.Errorf("struct %s not found in %s", structName, modelPath)
+	}
+
+	return fields, nil
+}
+
+// matchModelFields returns an error when fieldSpecs describe other columns than the existing model.
+func matchModelFields(modelPath string, fields []Field, fieldSpecs []string) error {
+	specFields, err := ParseFields(fieldSpecs)
+	if err != nil {
+		return err
+	}
+
+	same := len(specFields) == len(fields)
+	for i := 0; same && i < len(fields); i++ {
+		same = specFields[i].Column == fields[i].Column &&
+			specFields[i].GoType == fields[i].GoType &&
+			specFields[i].Optional == fields[i].Optional
+	}
+	if same {
+		return nil
+	}
+
+	return fmt.Errorf("model %s already exists with fields (%s), which differ from the given (%s); "+
+		"update the model or run the command without field specs", modelPath, describeFields(fields), describeFields(specFields))
+}
+
+// describeFields lists fields as "column:GoType", e.g. "name:string, age:*int".
+func describeFields(fields []Field) string {
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		goType := f.GoType
+		if f.Optional {
+			goType = "*" + goType
+		}
+		parts[i] = f.Column + ":" + goType
+	}
+	return strings.Join(parts, ", ")
+}
+
+// modelField converts a struct field of an existing model back into a Field.
+func modelField(name, column string, expr ast.Expr) Field {
+	field := Field{Name: name, Column: column}
+
+	if star, ok := expr.(*ast.StarExpr); ok {
+		field.Optional = true
+		expr = star.X
+	}
+
+	switch t := expr.(type) {
+	case *ast.Ident:
+		field.GoType = t.Name
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok {
+			field.GoType = pkg.Name + "." + t.Sel.Name
+		}
+	}
+
+	switch field.GoType {
+	case "int", "int32":
+		field.Type = "int"
+	case "int64":
+		field.Type = "int64"
+	case "float32", "float64":
+		field.Type = "float"
+	case "bool":
+		field.Type = "bool"
+	case "time.Time":
+		field.Type = "time"
+	default:
+		field.Type = "string"
+	}
+
+	return field
+}
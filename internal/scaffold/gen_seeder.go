@@ -0,0 +1,40 @@
+package scaffold
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/database"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// GenerateSeeder creates a timestamped YAML fixture in the seeds directory.
+// The fixture targets a table named after the seeder, e.g. "users".
+func GenerateSeeder(meta *core.ProjectMetadata, name string) error {
+	driver := meta.SelectedDatabaseDriver
+	if driver == "" || driver == "None" {
+		return fmt.Errorf("seeders require a database, but this project was generated without one")
+	}
+
+	fileName := naming.Snake(name)
+	if fileName == "" {
+		return fmt.Errorf("invalid seeder name '%s'", name)
+	}
+
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		StructName:    fileName,
+		TableName:     fileName,
+	}
+
+	templatePath := filepath.Join("common", "seeds", "fixture.yaml.tmpl")
+	targetPath := filepath.Join(database.SeedsDir, fmt.Sprintf("%s_%s.yaml", fileTimestamp(), fileName))
+
+	if err := renderFile(templatePath, targetPath, data); err != nil {
+		return err
+	}
+
+	fmt.Printf("   Created seeder: %s\n", targetPath)
+	return nil
+}
@@ -0,0 +1,61 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// GenerateService generates a service interface, its default implementation and a mock.
+// When a repository with the same name exists, the service is built on top of it.
+func GenerateService(meta *core.ProjectMetadata, name string, opts Options) error {
+	fileName := naming.Snake(name)
+	if fileName == "" {
+		return fmt.Errorf("invalid service name '%s'", name)
+	}
+
+	repositoryPath := filepath.Join(layerDir(meta.ProjectScale, "repository"), fileName+"_repository.go")
+	_, statErr := os.Stat(repositoryPath)
+
+	data := TemplateData{
+		ProjectConfig: meta.Config(),
+		PackageName:   "services",
+		StructName:    naming.Pascal(fileName),
+		HasRepository: statErr == nil,
+	}
+
+	if data.HasRepository {
+		fmt.Printf("   Using repository: %s\n", repositoryPath)
+	}
+
+	targetDir := layerDir(meta.ProjectScale, "services")
+	files := []templateFile{
+		{"service.tmpl", fileName + "_service.go"},
+		{"mock.tmpl", fileName + "_service_mock.go"},
+	}
+	if opts.WithTests {
+		files = append(files, templateFile{testTemplate(meta, "service_test.tmpl"), fileName + "_service_test.go"})
+	}
+
+	for _, file := range files {
+		templatePath := filepath.Join("common", "services", file.template)
+		targetPath := filepath.Join(targetDir, file.output)
+
+		if err := renderFile(templatePath, targetPath, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("   Created service: %s\n", targetPath)
+	}
+
+	if opts.WithTests {
+		if err := prepareTests(meta, targetDir, data.PackageName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
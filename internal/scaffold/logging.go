@@ -0,0 +1,38 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/generators/common"
+)
+
+// prepareLogger makes sure the project has the logger package used by generated handlers and middleware.
+// Projects created before the logger step get one backed by the logger recorded in gocrafting-cli.json (slog by default).
+func prepareLogger(meta *core.ProjectMetadata) error {
+	loggerPath := filepath.Join(layerDir(meta.ProjectScale, "logger"), "logger.go")
+
+	if _, err := os.Stat(loggerPath); os.IsNotExist(err) {
+		config := core.ProjectConfig{
+			ProjectName:    ".",
+			ModuleName:     meta.ModuleName,
+			ProjectScale:   meta.ProjectScale,
+			SelectedLogger: meta.Logger(),
+		}
+		if err := common.GenerateLogger(config); err != nil {
+			return fmt.Errorf("failed to generate logger package: %w", err)
+		}
+
+		fmt.Printf("   Created logger: %s\n", loggerPath)
+	}
+
+	if missing := missingModules(core.LoggerPackages(meta.Logger())); len(missing) > 0 {
+		if err := installPackages(missing...); err != nil {
+			fmt.Printf("   ⚠️  Could not install %s: %v\n", meta.Logger(), err)
+		}
+	}
+
+	return nil
+}
@@ -6,8 +6,19 @@ import (
 	"github.com/xRiot45/gocrafting/internal/core"
 )
 
-// Run adalah traffic controller
-func Run(meta *core.ProjectMetadata, schematic, name string) error {
+// Options holds flags of the generate command that only some schematics use.
+type Options struct {
+	// Register adds the generated code to the project's wiring (e.g. middleware to the router).
+	Register bool
+	// WithTests renders a table-driven test next to every generated artifact.
+	WithTests bool
+	// From is the env file the config schematic reads its keys from, ".env.example" when empty.
+	From string
+}
+
+// Run adalah traffic controller.
+// Extra args are schematic specific, e.g. field specs for "model".
+func Run(meta *core.ProjectMetadata, schematic, name string, args []string, opts Options) error {
 
 	switch schematic {
 
@@ -16,30 +27,39 @@ func Run(meta *core.ProjectMetadata, schematic, name string) error {
 	// 	return GenerateResource(meta, name)
 
 	case "handler", "h":
-		return GenerateHandler(meta, name)
+		return GenerateHandler(meta, name, opts)
+
+	case "service", "s":
+		return GenerateService(meta, name, opts)
 
-	// case "service", "s":
-	// 	return GenerateService(meta, name)
+	// --- DATA LAYER ---
+	case "repository", "repo":
+		return GenerateRepository(meta, name, args, opts)
 
-	// // --- DATA LAYER ---
-	// case "repository", "repo":
-	// 	return GenerateRepository(meta, name)
+	case "model", "m":
+		return GenerateModel(meta, name, args, opts)
 
-	// case "model", "m":
-	// 	return GenerateModel(meta, name)
+	case "migration", "mig":
+		return GenerateMigration(meta, name)
 
-	// case "migration", "mig":
-	// 	return GenerateMigration(meta, name)
+	case "seeder", "seed":
+		return GenerateSeeder(meta, name)
 
-	// // --- SYSTEM ---
+	// --- SYSTEM ---
 	// case "docker", "d":
 	// 	return GenerateDocker(meta) // Mungkin tidak butuh param 'name'
 
-	// case "middleware", "mid":
-	// 	return GenerateMiddleware(meta, name)
+	case "middleware", "mid":
+		return GenerateMiddleware(meta, name, args, opts)
+
+	case "config", "conf":
+		return GenerateConfig(meta, opts)
+
+	case "proto", "grpc":
+		return GenerateProto(meta, name, opts)
 
-	// case "cron", "job":
-	// 	return GenerateCronJob(meta, name)
+	case "cron", "job":
+		return GenerateCronJob(meta, name, args, opts)
 
 	default:
 		return fmt.Errorf("unknown schematic: '%s'. Run 'gocrafting --help' to see available generators", schematic)
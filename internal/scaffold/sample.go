@@ -0,0 +1,70 @@
+package scaffold
+
+import (
+	"sort"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// sampleFieldSpecs cover every field type and modifier, so sample data reaches every template branch.
+var sampleFieldSpecs = []string{
+	"name:string",
+	"description:text:optional",
+	"stock:int",
+	"price:float64",
+	"active:bool",
+	"released_at:time:optional",
+}
+
+// SampleData returns schematic data for a "product" in the given project, one value per built-in
+// middleware kind, as used by 'gocrafting templates lint' to execute every schematic template.
+func SampleData(config core.ProjectConfig) []TemplateData {
+	fields, err := ParseFields(sampleFieldSpecs)
+	if err != nil {
+		panic(err)
+	}
+
+	table := naming.Plural("product")
+	base := TemplateData{
+		ProjectConfig: config,
+		PackageName:   "product",
+		StructName:    "Product",
+		TableName:     table,
+		Fields:        fields,
+		Queries:       buildQueries(config.SelectedDatabaseDriver, table, fields),
+		HasRepository: config.SelectedDatabaseDriver != "None",
+		Schedule:      "@every 1m",
+		Jobs:          []string{"Cleanup", "Report"},
+		FileName:      "product",
+		Source:        ".env.example",
+		ConfigFields: []ConfigField{
+			newConfigField("APP_NAME", "demo", []string{"Application name"}),
+			newConfigField("APP_PORT", "8080", nil),
+			newConfigField("DEBUG", "true", nil),
+			newConfigField("RATE", "0.5", nil),
+			newConfigField("TIMEOUT", "15s", nil),
+			newConfigField("MAX_UPLOAD", "10MB", nil),
+			newConfigField("API_KEY", "", []string{"required"}),
+		},
+	}
+	base.Services = []TemplateData{{ProjectConfig: config, StructName: "Product", FileName: "product"}}
+
+	kinds := map[string]bool{}
+	for _, kind := range middlewareKinds {
+		kinds[kind] = true
+	}
+	sorted := []string{""}
+	for kind := range kinds {
+		sorted = append(sorted, kind)
+	}
+	sort.Strings(sorted)
+
+	samples := make([]TemplateData, 0, len(sorted))
+	for _, kind := range sorted {
+		data := base
+		data.Kind = kind
+		samples = append(samples, data)
+	}
+	return samples
+}
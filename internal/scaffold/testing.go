@@ -0,0 +1,73 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/naming"
+	"github.com/xRiot45/gocrafting/internal/shell"
+)
+
+// testTemplate returns the variant of a test template (e.g. "model_test.tmpl") for the project's testing style.
+// Ginkgo specs live next to it as "model_ginkgo_test.tmpl"; the standard library and Testify share one template.
+func testTemplate(meta *core.ProjectMetadata, name string) string {
+	if meta.TestingFramework() == core.TestingGinkgo {
+		return strings.TrimSuffix(name, "_test.tmpl") + "_ginkgo_test.tmpl"
+	}
+	return name
+}
+
+// prepareTests bootstraps what the generated tests of a package need:
+// the Ginkgo suite of targetDir and the packages of the project's testing style.
+func prepareTests(meta *core.ProjectMetadata, targetDir, packageName string) error {
+	if meta.TestingFramework() == core.TestingGinkgo {
+		suitePath := filepath.Join(targetDir, packageName+"_suite_test.go")
+
+		if _, err := os.Stat(suitePath); os.IsNotExist(err) {
+			data := TemplateData{PackageName: packageName, StructName: naming.Pascal(packageName)}
+			if err := renderFile(filepath.Join("common", "testing", "suite_test.tmpl"), suitePath, data); err != nil {
+				return err
+			}
+
+			fmt.Printf("   Created test suite: %s\n", suitePath)
+		}
+	}
+
+	if missing := missingModules(core.TestingPackages(meta.TestingFramework())); len(missing) > 0 {
+		if err := installPackages(missing...); err != nil {
+			fmt.Printf("   ⚠️  Could not install %s: %v\n", meta.TestingFramework(), err)
+		}
+	}
+
+	return nil
+}
+
+// missingModules returns the packages not yet required in the project's go.mod.
+// Packages may carry a version, e.g. "github.com/stretchr/testify@v1.12.1".
+func missingModules(packages []string) []string {
+	goMod, err := os.ReadFile("go.mod")
+	if err != nil {
+		return packages
+	}
+
+	var missing []string
+	for _, pkg := range packages {
+		modPath, _, _ := strings.Cut(pkg, "@")
+		if !strings.Contains(string(goMod), modPath+" ") {
+			missing = append(missing, pkg)
+		}
+	}
+	return missing
+}
+
+// installPackages installs packages into the project in the working directory
+// and records the resolved versions in gocrafting-cli.json.
+func installPackages(packages ...string) error {
+	if err := shell.GoGet(".", packages...); err != nil {
+		return err
+	}
+	return core.RecordDependencies(".")
+}
@@ -3,9 +3,43 @@ package shell
 
 import (
 	"fmt"
+	"os"
 	"os/exec"
 )
 
+// Executor runs an external command in a directory and returns its combined output.
+type Executor interface {
+	Run(dir, name string, args ...string) ([]byte, error)
+}
+
+// ExecExecutor runs commands with os/exec.
+type ExecExecutor struct {
+	// Env holds extra "KEY=value" variables, e.g. GOPROXY=off, overriding the inherited environment.
+	Env []string
+}
+
+// Run executes the command in dir.
+func (e ExecExecutor) Run(dir, name string, args ...string) ([]byte, error) {
+	// #nosec G204 -- Commands and arguments are controlled internally by the generator, safe from injection.
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	if len(e.Env) > 0 {
+		cmd.Env = append(os.Environ(), e.Env...)
+	}
+	return cmd.CombinedOutput()
+}
+
+// executor runs every command of the package; tests replace it with a fake to stay offline.
+var executor Executor = ExecExecutor{}
+
+// SetExecutor replaces the executor used by every function of the package
+// and returns a function restoring the previous one.
+func SetExecutor(e Executor) (restore func()) {
+	previous := executor
+	executor = e
+	return func() { executor = previous }
+}
+
 // GoGet installs the specified Go packages using 'go get'.
 func GoGet(projectPath string, packages ...string) error {
 	if len(packages) == 0 {
@@ -14,31 +48,23 @@ func GoGet(projectPath string, packages ...string) error {
 
 	args := append([]string{"get"}, packages...)
 
-	// #nosec G204 -- Arguments are controlled internally by the generator, safe from injection.
-	cmd := exec.Command("go", args...)
-	cmd.Dir = projectPath
-
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to install packages: %w", err)
+	if output, err := executor.Run(projectPath, "go", args...); err != nil {
+		return fmt.Errorf("failed to install packages: %w\n%s", err, output)
 	}
 	return nil
 }
 
 // RunGoModTidy executes 'go mod tidy' to clean up dependencies.
 func RunGoModTidy(projectPath string) error {
-	cmd := exec.Command("go", "mod", "tidy")
-	cmd.Dir = projectPath
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to run go mod tidy: %w", err)
+	if output, err := executor.Run(projectPath, "go", "mod", "tidy"); err != nil {
+		return fmt.Errorf("failed to run go mod tidy: %w\n%s", err, output)
 	}
 	return nil
 }
 
 // RunGoFmt executes 'go fmt ./...' to format the project code.
 func RunGoFmt(projectPath string) error {
-	cmd := exec.Command("go", "fmt", "./...")
-	cmd.Dir = projectPath
-	if err := cmd.Run(); err != nil {
+	if _, err := executor.Run(projectPath, "go", "fmt", "./..."); err != nil {
 		return fmt.Errorf("failed to run go fmt: %w", err)
 	}
 	return nil
@@ -48,7 +74,15 @@ func RunGoFmt(projectPath string) error {
 func RunGitInit(projectPath string) error {
 	fmt.Println("create git repository...")
 
-	cmd := exec.Command("git", "init")
-	cmd.Dir = projectPath
-	return cmd.Run()
+	_, err := executor.Run(projectPath, "git", "init")
+	return err
+}
+
+// RunMake executes 'make <target>' in the project path.
+// The command output is included in the error so failures can be diagnosed.
+func RunMake(projectPath, target string) error {
+	if output, err := executor.Run(projectPath, "make", target); err != nil {
+		return fmt.Errorf("failed to run make %s: %w\n%s", target, err, output)
+	}
+	return nil
 }
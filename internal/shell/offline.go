@@ -0,0 +1,208 @@
+package shell
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+
+	"github.com/xRiot45/gocrafting/internal/modcache"
+)
+
+var (
+	// lookupDisabledPattern matches modules go could not resolve with GOPROXY=off,
+	// e.g. "go: github.com/lib/pq@v1.12.3: module lookup disabled by GOPROXY=off", or the package
+	// of "main.go:5:2: cannot find module providing package github.com/spf13/viper: module lookup disabled".
+	lookupDisabledPattern = regexp.MustCompile(`(?:cannot find module providing package (\S+)|(\S+?)): module lookup disabled`)
+	// proxyFilePattern matches files go could not read from a file:// proxy,
+	// e.g. "reading file:///srv/goproxy/github.com/lib/pq/@v/v1.12.3.mod: no such file or directory".
+	proxyFilePattern = regexp.MustCompile(`reading file://(\S+?)/@v/(\S+?)(?:\.mod|\.zip|\.info)?: `)
+)
+
+// OfflineExecutor runs commands without network access: modules are resolved from the local
+// module cache (GOPROXY=off), or from a GOPROXY=file:// directory when one is configured.
+// 'go get' installs pinned versions, checking first that they are available,
+// and failures list exactly which modules are missing.
+type OfflineExecutor struct {
+	source *modcache.Cache
+	label  string
+	pins   map[string]string
+	exec   ExecExecutor
+}
+
+// NewOfflineExecutor returns an executor resolving modules from proxyDir, or from the GOPROXY
+// environment variable when it is a file:// URL, or else from the module cache.
+// pins maps module paths to the versions installed for packages given without one, e.g. core.PinnedVersions().
+func NewOfflineExecutor(proxyDir string, pins map[string]string) (*OfflineExecutor, error) {
+	// The sources are local, so there is no network to check sums or download toolchains with either
+	env := []string{"GOFLAGS=-mod=mod", "GOSUMDB=off", "GOWORK=off", "GOTOOLCHAIN=local"}
+
+	if proxyDir == "" {
+		proxyDir = fileProxyDir(os.Getenv("GOPROXY"))
+	}
+
+	if proxyDir != "" {
+		abs, err := filepath.Abs(proxyDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve proxy directory: %w", err)
+		}
+		if info, err := os.Stat(abs); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("proxy directory not found: %s", abs)
+		}
+
+		return &OfflineExecutor{
+			source: modcache.At(abs),
+			label:  "GOPROXY=file://" + filepath.ToSlash(abs),
+			pins:   pins,
+			exec:   ExecExecutor{Env: append(env, "GOPROXY=file://"+filepath.ToSlash(abs))},
+		}, nil
+	}
+
+	cache, err := modcache.Open()
+	if err != nil {
+		return nil, err
+	}
+	return &OfflineExecutor{
+		source: cache,
+		label:  "the module cache (" + cache.Dir() + ")",
+		pins:   pins,
+		exec:   ExecExecutor{Env: append(env, "GOPROXY=off")},
+	}, nil
+}
+
+// fileProxyDir returns the directory of the first GOPROXY entry when it is a file:// URL, "" otherwise.
+func fileProxyDir(goproxy string) string {
+	first, _, _ := strings.Cut(goproxy, ",")
+	first, _, _ = strings.Cut(first, "|")
+
+	parsed, err := url.Parse(strings.TrimSpace(first))
+	if err != nil || parsed.Scheme != "file" {
+		return ""
+	}
+	return filepath.FromSlash(parsed.Path)
+}
+
+// Run executes the command offline. 'go get' packages without a version are pinned with pins, or to the
+// newest available version when pins has none, and rejected before running when they are missing.
+func (o *OfflineExecutor) Run(dir, name string, args ...string) ([]byte, error) {
+	if name == "go" && len(args) > 0 && args[0] == "get" {
+		pinned, missing := o.pin(args[1:])
+		if len(missing) > 0 {
+			return nil, o.missingError(missing)
+		}
+		args = append([]string{"get"}, pinned...)
+	}
+
+	output, err := o.exec.Run(dir, name, args...)
+	if err != nil && name == "go" {
+		// The list replaces go's output, which repeats every missing module for each importing package
+		if missing := o.missingModules(output); len(missing) > 0 {
+			return nil, o.missingError(missing)
+		}
+	}
+	return output, err
+}
+
+// pin appends the version to every package given without one, and returns the modules not available.
+// Packages given with an exact version, e.g. "github.com/lib/pq@v1.12.3", are only checked.
+func (o *OfflineExecutor) pin(packages []string) ([]string, []string) {
+	var pinned, missing []string
+	for _, arg := range packages {
+		pkg, version, hasVersion := strings.Cut(arg, "@")
+
+		var modPath string
+		if hasVersion {
+			modPath = o.moduleOf(pkg, version)
+		} else if modPath, version = o.pinnedModule(pkg); version == "" {
+			latest, ok := o.source.Latest(pkg)
+			if !ok {
+				missing = append(missing, pkg)
+				continue
+			}
+			modPath, version = latest.Path, latest.Version
+		}
+
+		// Version queries such as "latest" cannot be checked up front, go reports them if they fail
+		if semver.IsValid(version) && (modPath == "" || !o.source.Has(modPath, version)) {
+			missing = append(missing, pkg+"@"+version)
+			continue
+		}
+		pinned = append(pinned, pkg+"@"+version)
+	}
+	return pinned, missing
+}
+
+// moduleOf returns the module providing pkg at version: the pinned module, or the longest
+// module path available at that version. It returns "" when none is available.
+func (o *OfflineExecutor) moduleOf(pkg, version string) string {
+	if modPath, pinned := o.pinnedModule(pkg); pinned == version {
+		return modPath
+	}
+	for modPath := pkg; modPath != "." && modPath != "/"; modPath = path.Dir(modPath) {
+		if o.source.Has(modPath, version) {
+			return modPath
+		}
+	}
+	return ""
+}
+
+// pinnedModule returns the module providing pkg and its pinned version, "" when it is not pinned.
+func (o *OfflineExecutor) pinnedModule(pkg string) (string, string) {
+	for modPath := pkg; modPath != "." && modPath != "/"; modPath = path.Dir(modPath) {
+		if version, ok := o.pins[modPath]; ok {
+			return modPath, version
+		}
+	}
+	return "", ""
+}
+
+// missingModules extracts the modules go failed to find from its output.
+func (o *OfflineExecutor) missingModules(output []byte) []string {
+	seen := map[string]bool{}
+	for _, match := range lookupDisabledPattern.FindAllSubmatch(output, -1) {
+		seen[string(match[1])+string(match[2])] = true
+	}
+
+	prefix := filepath.ToSlash(o.source.Dir()) + "/"
+	for _, match := range proxyFilePattern.FindAllSubmatch(output, -1) {
+		escapedPath, found := strings.CutPrefix(string(match[1]), prefix)
+		if !found {
+			continue
+		}
+
+		modPath, err := module.UnescapePath(escapedPath)
+		if err != nil {
+			continue
+		}
+		if version, err := module.UnescapeVersion(string(match[2])); err == nil && version != "list" {
+			modPath += "@" + version
+		}
+		seen[modPath] = true
+	}
+
+	missing := make([]string, 0, len(seen))
+	for modPath := range seen {
+		missing = append(missing, modPath)
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// missingError lists the missing modules and how to make them available.
+func (o *OfflineExecutor) missingError(missing []string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "offline mode: %d module(s) missing from %s:\n", len(missing), o.label)
+	for _, modPath := range missing {
+		fmt.Fprintf(&b, "  - %s\n", modPath)
+	}
+	b.WriteString("download them with network access ('go mod download <module>@<version>') or set GOPROXY=file://<dir> to a directory holding them")
+	return errors.New(b.String())
+}
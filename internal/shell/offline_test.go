@@ -0,0 +1,202 @@
+package shell
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xRiot45/gocrafting/internal/modcache"
+)
+
+// newOffline returns an executor over a temp module cache holding the given module@version zips.
+func newOffline(t *testing.T, pins map[string]string, modules ...string) *OfflineExecutor {
+	t.Helper()
+
+	dir := t.TempDir()
+	for _, mod := range modules {
+		escapedPath, version, _ := strings.Cut(mod, "@")
+		versionDir := filepath.Join(dir, filepath.FromSlash(escapedPath), "@v")
+		if err := os.MkdirAll(versionDir, 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(versionDir, version+".zip"), nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &OfflineExecutor{source: modcache.At(dir), label: "the test cache", pins: pins}
+}
+
+func TestOfflinePin(t *testing.T) {
+	executor := newOffline(t,
+		map[string]string{
+			"github.com/gin-gonic/gin":    "v1.12.0",
+			"github.com/lib/pq":           "v1.12.3",
+			"go.mongodb.org/mongo-driver": "v1.17.4",
+		},
+		"github.com/gin-gonic/gin@v1.12.0",
+		"github.com/gin-gonic/gin@v1.13.0",
+		"go.mongodb.org/mongo-driver@v1.17.4",
+		"github.com/joho/godotenv@v1.5.1",
+		"github.com/joho/godotenv@v1.6.0-rc.1",
+		"github.com/!burnt!sushi/toml@v1.5.0",
+	)
+
+	tests := []struct {
+		name     string
+		packages []string
+		pinned   []string
+		missing  []string
+	}{
+		{
+			name:     "pinned present",
+			packages: []string{"github.com/gin-gonic/gin"},
+			pinned:   []string{"github.com/gin-gonic/gin@v1.12.0"},
+		},
+		{
+			name:     "pinned package of a module",
+			packages: []string{"go.mongodb.org/mongo-driver/mongo/options"},
+			pinned:   []string{"go.mongodb.org/mongo-driver/mongo/options@v1.17.4"},
+		},
+		{
+			name:     "pinned missing",
+			packages: []string{"github.com/lib/pq", "github.com/gin-gonic/gin"},
+			pinned:   []string{"github.com/gin-gonic/gin@v1.12.0"},
+			missing:  []string{"github.com/lib/pq@v1.12.3"},
+		},
+		{
+			name:     "unpinned falls back to the newest release",
+			packages: []string{"github.com/joho/godotenv", "github.com/BurntSushi/toml"},
+			pinned:   []string{"github.com/joho/godotenv@v1.5.1", "github.com/BurntSushi/toml@v1.5.0"},
+		},
+		{
+			name:     "unpinned missing",
+			packages: []string{"github.com/spf13/viper"},
+			missing:  []string{"github.com/spf13/viper"},
+		},
+		{
+			name:     "explicit version present",
+			packages: []string{"github.com/gin-gonic/gin@v1.13.0", "github.com/gin-gonic/gin/binding@v1.13.0"},
+			pinned:   []string{"github.com/gin-gonic/gin@v1.13.0", "github.com/gin-gonic/gin/binding@v1.13.0"},
+		},
+		{
+			name:     "explicit version missing",
+			packages: []string{"github.com/gin-gonic/gin@v1.9.1"},
+			missing:  []string{"github.com/gin-gonic/gin@v1.9.1"},
+		},
+		{
+			name:     "version query left to go",
+			packages: []string{"github.com/spf13/viper@latest"},
+			pinned:   []string{"github.com/spf13/viper@latest"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pinned, missing := executor.pin(tt.packages)
+			if strings.Join(pinned, " ") != strings.Join(tt.pinned, " ") {
+				t.Errorf("pin() pinned = %v, want %v", pinned, tt.pinned)
+			}
+			if strings.Join(missing, " ") != strings.Join(tt.missing, " ") {
+				t.Errorf("pin() missing = %v, want %v", missing, tt.missing)
+			}
+		})
+	}
+}
+
+func TestOfflineRunRejectsMissingModules(t *testing.T) {
+	executor := newOffline(t, map[string]string{"github.com/lib/pq": "v1.12.3", "github.com/gin-gonic/gin": "v1.12.0"})
+
+	// Nothing runs: the check fails before go is started
+	_, err := executor.Run(t.TempDir(), "go", "get", "github.com/lib/pq", "github.com/gin-gonic/gin")
+	want := "offline mode: 2 module(s) missing from the test cache:\n" +
+		"  - github.com/lib/pq@v1.12.3\n" +
+		"  - github.com/gin-gonic/gin@v1.12.0\n" +
+		"download them"
+	if err == nil || !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("Run() error = %v, want it to start with %q", err, want)
+	}
+}
+
+func TestOfflineMissingModules(t *testing.T) {
+	executor := newOffline(t, nil)
+	proxy := filepath.ToSlash(executor.source.Dir())
+
+	tests := []struct {
+		name   string
+		output string
+		want   []string
+	}{
+		{
+			name: "module lookup disabled",
+			output: "go: github.com/lib/pq@v1.12.3: module lookup disabled by GOPROXY=off\n" +
+				"main.go:5:2: cannot find module providing package github.com/spf13/viper: module lookup disabled by GOPROXY=off\n" +
+				"example.com/app imports\n\tgithub.com/joho/godotenv: cannot find module providing package github.com/joho/godotenv: module lookup disabled by GOPROXY=off\n" +
+				"go: github.com/lib/pq@v1.12.3: module lookup disabled by GOPROXY=off\n",
+			want: []string{"github.com/joho/godotenv", "github.com/lib/pq@v1.12.3", "github.com/spf13/viper"},
+		},
+		{
+			name: "file proxy",
+			output: "go: github.com/BurntSushi/toml@v1.5.0: reading file://" + proxy + "/github.com/!burnt!sushi/toml/@v/v1.5.0.mod: no such file or directory\n" +
+				"go: example.com/upper@v1.0.0-RC1: reading file://" + proxy + "/example.com/upper/@v/v1.0.0-!r!c1.zip: no such file or directory\n" +
+				"go: reading file://" + proxy + "/github.com/spf13/viper/@v/list: no such file or directory\n",
+			want: []string{"example.com/upper@v1.0.0-RC1", "github.com/BurntSushi/toml@v1.5.0", "github.com/spf13/viper"},
+		},
+		{
+			name:   "other file proxy",
+			output: "go: reading file:///srv/other/github.com/lib/pq/@v/v1.12.3.mod: no such file or directory\n",
+		},
+		{
+			name:   "compile error",
+			output: "./main.go:3:1: syntax error: non-declaration statement outside function body\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := executor.missingModules([]byte(tt.output))
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("missingModules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileProxyDir(t *testing.T) {
+	tests := []struct {
+		goproxy string
+		want    string
+	}{
+		{goproxy: "", want: ""},
+		{goproxy: "off", want: ""},
+		{goproxy: "https://proxy.golang.org,direct", want: ""},
+		{goproxy: "file:///srv/goproxy", want: filepath.FromSlash("/srv/goproxy")},
+		{goproxy: "file:///srv/goproxy,https://proxy.golang.org", want: filepath.FromSlash("/srv/goproxy")},
+		{goproxy: "file:///srv/goproxy|direct", want: filepath.FromSlash("/srv/goproxy")},
+		{goproxy: "https://proxy.golang.org,file:///srv/goproxy", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goproxy, func(t *testing.T) {
+			if got := fileProxyDir(tt.goproxy); got != tt.want {
+				t.Errorf("fileProxyDir(%q) = %q, want %q", tt.goproxy, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewOfflineExecutorProxyDir(t *testing.T) {
+	if _, err := NewOfflineExecutor(filepath.Join(t.TempDir(), "missing"), nil); err == nil || !strings.Contains(err.Error(), "proxy directory not found") {
+		t.Errorf("NewOfflineExecutor() error = %v, want proxy directory not found", err)
+	}
+
+	dir := t.TempDir()
+	executor, err := NewOfflineExecutor(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if executor.label != "GOPROXY=file://"+filepath.ToSlash(dir) || executor.source.Dir() != dir {
+		t.Errorf("NewOfflineExecutor() label = %q, dir = %q", executor.label, executor.source.Dir())
+	}
+}
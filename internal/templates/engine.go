@@ -0,0 +1,162 @@
+package templates
+
+import (
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Engine is the rendering subsystem shared by 'new' (project templates, add-ons, template packs)
+// and 'generate' (schematics): it reads templates through the override layers, renders ".tmpl"
+// files with the shared helper functions, gofmts Go output and writes everything below one root.
+type Engine struct {
+	fsys fs.FS
+	root string
+}
+
+// NewEngine returns an engine reading templates from defaults, layered under the project and user
+// overrides, and writing below root (the new project folder, or "." inside an existing project).
+func NewEngine(root string, defaults fs.FS) *Engine {
+	return &Engine{fsys: Overlay(defaults), root: root}
+}
+
+// ReadFile returns the raw content of the template at name, e.g. "common/makefile.tmpl".
+func (e *Engine) ReadFile(name string) ([]byte, error) {
+	content, err := fs.ReadFile(e.fsys, path.Clean(filepath.ToSlash(name)))
+	if err != nil {
+		return nil, fmt.Errorf("template not found: %s", name)
+	}
+	return content, nil
+}
+
+// Execute renders the template at source with data and reports whether it is included:
+// a template whose front-matter "when" condition is false is not. Files without the ".tmpl"
+// suffix are returned as they are. Error messages point at lines of the template file.
+func (e *Engine) Execute(source string, data any) ([]byte, bool, error) {
+	content, err := e.ReadFile(source)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !strings.HasSuffix(source, ".tmpl") {
+		return content, true, nil
+	}
+
+	matter, body, err := splitFrontMatter(source, content)
+	if err != nil {
+		return nil, false, err
+	}
+
+	include, err := evalCondition(source, matter.When, data)
+	if err != nil || !include {
+		return nil, false, err
+	}
+
+	rendered, err := Render(source, body, data)
+	if err != nil {
+		return nil, false, shiftLines(err, source, matter.Lines)
+	}
+	return rendered, true, nil
+}
+
+// RenderFile renders the template at source into target, relative to the engine root.
+// Templates excluded by their front-matter are skipped.
+func (e *Engine) RenderFile(source, target string, data any) error {
+	content, include, err := e.Execute(source, data)
+	if err != nil || !include {
+		return err
+	}
+	return e.WriteFile(target, content)
+}
+
+// RenderDir renders every file under sourceDir into targetDir, relative to the engine root,
+// dropping the ".tmpl" suffix of rendered files.
+func (e *Engine) RenderDir(sourceDir, targetDir string, data any) error {
+	sources, err := e.Sources(sourceDir, data)
+	if err != nil {
+		return err
+	}
+
+	for _, source := range sources {
+		relPath := strings.TrimPrefix(strings.TrimPrefix(source, sourceDir), "/")
+		target := filepath.Join(targetDir, filepath.FromSlash(strings.TrimSuffix(relPath, ".tmpl")))
+		if err := e.RenderFile(source, target, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sources lists the template files under sourceDir. A directory holding a .when file whose
+// condition is false for data is left out with everything inside it.
+func (e *Engine) Sources(sourceDir string, data any) ([]string, error) {
+	var sources []string
+	err := fs.WalkDir(e.fsys, sourceDir, func(current string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			include, err := e.includeDir(current, data)
+			if err != nil || include {
+				return err
+			}
+			return fs.SkipDir
+		}
+
+		if d.Name() != WhenFile {
+			sources = append(sources, current)
+		}
+		return nil
+	})
+	return sources, err
+}
+
+// includeDir evaluates the .when file of dir, directories without one are always included.
+func (e *Engine) includeDir(dir string, data any) (bool, error) {
+	expr, err := fs.ReadFile(e.fsys, path.Join(dir, WhenFile))
+	if err != nil {
+		return true, nil
+	}
+	return evalCondition(path.Join(dir, WhenFile), strings.TrimSpace(string(expr)), data)
+}
+
+// WriteFile writes content to target, relative to the engine root, creating its directory.
+// Go sources are formatted with gofmt first, so every generator emits the same layout.
+func (e *Engine) WriteFile(target string, content []byte) error {
+	if strings.HasSuffix(target, ".go") {
+		formatted, err := format.Source(content)
+		if err != nil {
+			return fmt.Errorf("generated code for %s is not valid Go: %w", target, err)
+		}
+		content = formatted
+	}
+
+	if err := e.mkdir(filepath.Dir(target)); err != nil {
+		return err
+	}
+
+	fullPath := e.Path(target)
+	if err := os.WriteFile(fullPath, content, 0600); err != nil {
+		return fmt.Errorf("failed to write file %s: %w", fullPath, err)
+	}
+	return nil
+}
+
+// Path returns target joined to the engine root.
+func (e *Engine) Path(target string) string {
+	return filepath.Clean(filepath.Join(e.root, target))
+}
+
+// mkdir creates dir below the engine root.
+func (e *Engine) mkdir(dir string) error {
+	fullPath := e.Path(dir)
+	if err := os.MkdirAll(fullPath, 0750); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", fullPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// WhenFile is the name of the file that makes a whole template directory conditional.
+// It holds a single expression, e.g. `eq .SelectedFramework "Gin"`, and is never written to the project.
+const WhenFile = ".when"
+
+// frontMatterDelimiter opens and closes the front-matter block at the top of a template:
+//
+//	---
+//	when: ne .SelectedDatabaseDriver "None"
+//	---
+//	package main
+const frontMatterDelimiter = "---"
+
+// frontMatter holds the settings declared at the top of a template.
+type frontMatter struct {
+	// When is a template expression over the data; the file is only emitted when it is true.
+	When string
+	// Lines is the number of lines taken by the front-matter, used to report template lines.
+	Lines int
+}
+
+// splitFrontMatter separates the front-matter of a template from its body.
+// Templates without front-matter are returned unchanged.
+func splitFrontMatter(name string, content []byte) (frontMatter, []byte, error) {
+	var matter frontMatter
+
+	normalized := bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	if !bytes.HasPrefix(normalized, []byte(frontMatterDelimiter+"\n")) {
+		return matter, content, nil
+	}
+
+	rest := "\n" + string(normalized[len(frontMatterDelimiter)+1:])
+	header, body, found := strings.Cut(rest, "\n"+frontMatterDelimiter+"\n")
+	if !found {
+		// A template made only of front-matter may end right after the closing delimiter
+		if header, found = strings.CutSuffix(rest, "\n"+frontMatterDelimiter); !found {
+			return matter, nil, fmt.Errorf("front-matter of %s is not closed with '%s'", name, frontMatterDelimiter)
+		}
+	}
+
+	matter.Lines = strings.Count(header, "\n") + 2
+	for _, line := range strings.Split(header, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return matter, nil, fmt.Errorf("invalid front-matter line in %s: %s", name, line)
+		}
+
+		switch strings.TrimSpace(key) {
+		case "when":
+			matter.When = strings.TrimSpace(value)
+		default:
+			return matter, nil, fmt.Errorf("unknown front-matter key '%s' in %s", key, name)
+		}
+	}
+
+	return matter, []byte(body), nil
+}
+
+// evalCondition reports whether the template expression is true for data, e.g.
+// `ne .SelectedDatabaseDriver "None"` or `and (eq .SelectedFramework "Gin") (hasAddon "docker")`.
+// An empty expression is always true.
+func evalCondition(name, expr string, data any) (bool, error) {
+	if expr == "" {
+		return true, nil
+	}
+
+	result, err := Render(name, []byte("{{ if "+expr+" }}true{{ end }}"), data)
+	if err != nil {
+		return false, fmt.Errorf("invalid condition in %s: %w", name, err)
+	}
+	return string(result) == "true", nil
+}
+
+// shiftLines moves the line numbers reported by text/template for name past the front-matter,
+// so "template: store.go.tmpl:12: ..." points at line 12 of the file rather than of its body.
+func shiftLines(err error, name string, offset int) error {
+	if offset == 0 {
+		return err
+	}
+
+	pattern := regexp.MustCompile(regexp.QuoteMeta(name) + `:(\d+)`)
+	message := pattern.ReplaceAllStringFunc(err.Error(), func(match string) string {
+		line, _ := strconv.Atoi(match[len(name)+1:])
+		return name + ":" + strconv.Itoa(line+offset)
+	})
+	return &shiftedError{message: message, err: err}
+}
+
+// shiftedError is an error whose message has its template line numbers shifted by shiftLines.
+// It unwraps to the original error, so errors.Is and errors.As still see the cause.
+type shiftedError struct {
+	message string
+	err     error
+}
+
+func (e *shiftedError) Error() string { return e.message }
+
+func (e *shiftedError) Unwrap() error { return e.err }
@@ -0,0 +1,217 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"text/template"
+)
+
+func TestSplitFrontMatter(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		when    string
+		lines   int
+		body    string
+		err     string
+	}{
+		{name: "no front-matter", content: "package main\n", body: "package main\n"},
+		{name: "no front-matter keeps CRLF", content: "package main\r\n", body: "package main\r\n"},
+		{name: "delimiter not on first line", content: "\n---\nwhen: true\n---\nbody", body: "\n---\nwhen: true\n---\nbody"},
+		{name: "when", content: "---\nwhen: eq .Name \"app\"\n---\nbody\n", when: `eq .Name "app"`, lines: 3, body: "body\n"},
+		{name: "CRLF", content: "---\r\nwhen: true\r\n---\r\nbody\r\n", when: "true", lines: 3, body: "body\n"},
+		{name: "comments and blank lines", content: "---\n# generated only with Gin\n\nwhen:  true  \n---\nbody", when: "true", lines: 5, body: "body"},
+		{name: "empty", content: "---\n---\nbody", lines: 2, body: "body"},
+		{name: "closed at end of file", content: "---\nwhen: false\n---", when: "false", lines: 3},
+		{name: "colon in value", content: "---\nwhen: eq .Port \"a:b\"\n---\n", when: `eq .Port "a:b"`, lines: 3},
+		{name: "unclosed", content: "---\nwhen: true\nbody\n", err: "front-matter of file.tmpl is not closed with '---'"},
+		{name: "unknown key", content: "---\nwhen: true\nif: true\n---\nbody", err: "unknown front-matter key 'if' in file.tmpl"},
+		{name: "line without key", content: "---\nwhen true\n---\nbody", err: "invalid front-matter line in file.tmpl: when true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matter, body, err := splitFrontMatter("file.tmpl", []byte(tt.content))
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("splitFrontMatter() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("splitFrontMatter() error = %v", err)
+			}
+			if matter.When != tt.when || matter.Lines != tt.lines {
+				t.Errorf("splitFrontMatter() = {When: %q, Lines: %d}, want {When: %q, Lines: %d}", matter.When, matter.Lines, tt.when, tt.lines)
+			}
+			if string(body) != tt.body {
+				t.Errorf("splitFrontMatter() body = %q, want %q", body, tt.body)
+			}
+		})
+	}
+}
+
+func TestEvalCondition(t *testing.T) {
+	data := struct {
+		addons
+		SelectedFramework      string
+		SelectedDatabaseDriver string
+	}{addons: addons{"docker"}, SelectedFramework: "Gin", SelectedDatabaseDriver: "None"}
+
+	tests := []struct {
+		expr string
+		want bool
+		err  string
+	}{
+		{expr: "", want: true},
+		{expr: "true", want: true},
+		{expr: `eq .SelectedFramework "Gin"`, want: true},
+		{expr: `ne .SelectedDatabaseDriver "None"`, want: false},
+		{expr: `and (eq .SelectedFramework "Gin") (hasAddon "docker")`, want: true},
+		{expr: `or (eq .SelectedFramework "Fiber") (hasAddon "swagger")`, want: false},
+		{expr: `.SelectedFramework`, want: true},
+		{expr: `eq .Missing "x"`, err: "invalid condition in cond"},
+		{expr: `eq .SelectedFramework`, err: "invalid condition in cond"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := evalCondition("cond", tt.expr, data)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("evalCondition(%q) error = %v, want %q", tt.expr, err, tt.err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("evalCondition(%q) = %v, %v; want %v", tt.expr, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestShiftLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		offset  int
+		want    string
+	}{
+		{
+			name:    "line and column",
+			message: `failed to execute template small/store.go.tmpl: template: small/store.go.tmpl:4:12: executing "small/store.go.tmpl" at <.Missing>: can't evaluate field Missing`,
+			offset:  3,
+			want:    `failed to execute template small/store.go.tmpl: template: small/store.go.tmpl:7:12: executing "small/store.go.tmpl" at <.Missing>: can't evaluate field Missing`,
+		},
+		{
+			name:    "parse error",
+			message: `template: small/store.go.tmpl:2: unclosed action`,
+			offset:  5,
+			want:    `template: small/store.go.tmpl:7: unclosed action`,
+		},
+		{
+			name:    "other templates untouched",
+			message: `template: other.tmpl:2: unclosed action`,
+			offset:  5,
+			want:    `template: other.tmpl:2: unclosed action`,
+		},
+		{
+			name:    "dots are not wildcards",
+			message: `template: smallXstoreXgoXtmpl:2: unclosed action`,
+			offset:  5,
+			want:    `template: smallXstoreXgoXtmpl:2: unclosed action`,
+		},
+		{
+			name:    "no offset",
+			message: `template: small/store.go.tmpl:2: unclosed action`,
+			want:    `template: small/store.go.tmpl:2: unclosed action`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := shiftLines(errors.New(tt.message), "small/store.go.tmpl", tt.offset)
+			if got.Error() != tt.want {
+				t.Errorf("shiftLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShiftLinesKeepsCause(t *testing.T) {
+	cause := template.ExecError{Name: "small/store.go.tmpl", Err: errors.New("template: small/store.go.tmpl:4:12: boom")}
+	err := shiftLines(fmt.Errorf("failed to execute template: %w", cause), "small/store.go.tmpl", 3)
+
+	if !strings.Contains(err.Error(), "small/store.go.tmpl:7:12") {
+		t.Errorf("shiftLines() = %q, want the line shifted to 7", err)
+	}
+	var execErr template.ExecError
+	if !errors.As(err, &execErr) || execErr.Name != cause.Name {
+		t.Errorf("errors.As(shiftLines()) = %v, want the template.ExecError", err)
+	}
+}
+
+func TestEngineFrontMatter(t *testing.T) {
+	// Keep user-level and project-local overrides out of the engine
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Chdir(t.TempDir())
+
+	engine := NewEngine(t.TempDir(), fstest.MapFS{
+		"app/main.go.tmpl":      {Data: []byte("package main\n")},
+		"app/db.go.tmpl":        {Data: []byte("---\nwhen: ne .Driver \"None\"\n---\npackage {{ .Driver | lower }}\n")},
+		"app/raw.txt":           {Data: []byte("---\nwhen: false\n---\nkept as is\n")},
+		"app/docker/.when":      {Data: []byte("hasAddon \"docker\"\n")},
+		"app/docker/Dockerfile": {Data: []byte("FROM golang\n")},
+		"app/broken.go.tmpl":    {Data: []byte("---\nwhen: true\n---\npackage main\n\n{{ .Missing }}\n")},
+	})
+
+	tests := []struct {
+		name    string
+		data    any
+		sources []string
+	}{
+		{
+			name:    "without database or docker",
+			data:    struct{ Driver string }{Driver: "None"},
+			sources: []string{"app/broken.go.tmpl", "app/db.go.tmpl", "app/main.go.tmpl", "app/raw.txt"},
+		},
+		{
+			name: "with docker",
+			data: struct {
+				addons
+				Driver string
+			}{addons: addons{"docker"}, Driver: "SQLite"},
+			sources: []string{"app/broken.go.tmpl", "app/db.go.tmpl", "app/docker/Dockerfile", "app/main.go.tmpl", "app/raw.txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources, err := engine.Sources("app", tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Join(sources, ",") != strings.Join(tt.sources, ",") {
+				t.Errorf("Sources() = %v, want %v", sources, tt.sources)
+			}
+		})
+	}
+
+	if _, include, err := engine.Execute("app/db.go.tmpl", struct{ Driver string }{Driver: "None"}); err != nil || include {
+		t.Errorf("Execute() with a false condition = %v, %v; want excluded", include, err)
+	}
+	if output, include, err := engine.Execute("app/db.go.tmpl", struct{ Driver string }{Driver: "SQLite"}); err != nil || !include || string(output) != "package sqlite\n" {
+		t.Errorf("Execute() = %q, %v, %v; want the body without front-matter", output, include, err)
+	}
+	if output, include, err := engine.Execute("app/raw.txt", nil); err != nil || !include || !strings.HasPrefix(string(output), "---\n") {
+		t.Errorf("Execute() of a file without .tmpl = %q, %v, %v; want it unchanged", output, include, err)
+	}
+
+	_, _, err := engine.Execute("app/broken.go.tmpl", struct{ Driver string }{})
+	if err == nil || !strings.Contains(err.Error(), "app/broken.go.tmpl:6:") {
+		t.Errorf("Execute() error = %v, want it at line 6 of the file", err)
+	}
+}
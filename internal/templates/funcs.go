@@ -0,0 +1,132 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/xRiot45/gocrafting/internal/dialect"
+	"github.com/xRiot45/gocrafting/internal/naming"
+)
+
+// addonSet is implemented by template data that knows the selected add-ons, e.g. core.ProjectConfig.
+type addonSet interface {
+	HasAddon(name string) bool
+}
+
+// FuncMap returns the helpers available to every template, project and schematic alike:
+//
+//	{{ .StructName | plural | kebab }}          case conversions and inflection
+//	{{ if hasAddon "docker" }}...{{ end }}       add-on checks by ID or label
+//	{{ dbPlaceholder .DatabaseDriver 1 }}        "$1" for PostgreSQL, "?" otherwise
+//	{{ dbQuote .DatabaseDriver "order" }}        quoted identifier, `order` for MySQL, "order" otherwise
+//	{{ .Port | default "8080" | quote }}         fallbacks and quoting
+//	{{ goString .Query }}                        raw Go string literal, double-quoted when it holds a backtick
+//	{{ indent 4 .Block }}                        indent every line
+//	{{ env "USER" "gopher" }}                    environment of the machine running gocrafting
+//	{{ raw " .Version " }}                       literal "{{ .Version }}" for tools like GoReleaser
+//
+// hasAddon reports false here; Render binds it to the add-ons of the rendered data.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"pascal":        naming.Pascal,
+		"camel":         naming.Camel,
+		"snake":         naming.Snake,
+		"kebab":         naming.Kebab,
+		"lower":         strings.ToLower,
+		"upper":         strings.ToUpper,
+		"plural":        naming.Plural,
+		"singular":      naming.Singular,
+		"hasAddon":      func(string) bool { return false },
+		"dbPlaceholder": dialect.Placeholder,
+		"dbQuote":       dialect.Quote,
+		"default":       defaultValue,
+		"quote":         quote,
+		"goString":      goString,
+		"indent":        indent,
+		"env":           env,
+		"raw":           raw,
+		"ldelim":        func() string { return "{{" },
+		"rdelim":        func() string { return "}}" },
+	}
+}
+
+// New returns an empty template named name with the helpers registered, bound to data.
+func New(name string, data any) *template.Template {
+	funcs := FuncMap()
+	if addons, ok := data.(addonSet); ok {
+		funcs["hasAddon"] = addons.HasAddon
+	}
+	return template.New(name).Funcs(funcs)
+}
+
+// Render parses content as the template name and executes it with data.
+func Render(name string, content []byte, data any) ([]byte, error) {
+	tmpl, err := New(name, data).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template %s: %w", name, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// defaultValue returns value, or def when value is the zero value of its type (sprig's "default").
+func defaultValue(def, value any) any {
+	if value == nil {
+		return def
+	}
+	if reflect.ValueOf(value).IsZero() {
+		return def
+	}
+	return value
+}
+
+// quote returns value as a double-quoted Go string literal.
+func quote(value any) string {
+	return strconv.Quote(fmt.Sprint(value))
+}
+
+// goString returns value as a raw Go string literal, or a double-quoted one when it cannot be raw.
+func goString(value string) string {
+	if strings.ContainsAny(value, "`\r") {
+		return strconv.Quote(value)
+	}
+	return "`" + value + "`"
+}
+
+// indent prefixes every non-empty line of text with n spaces.
+func indent(n int, text string) string {
+	pad := strings.Repeat(" ", n)
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = pad + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// env returns the environment variable key, or the first fallback when it is unset or empty.
+func env(key string, fallback ...string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	if len(fallback) > 0 {
+		return fallback[0]
+	}
+	return ""
+}
+
+// raw wraps text in literal template delimiters, so the output keeps "{{ ... }}" for another tool to expand.
+func raw(text string) string {
+	return "{{" + text + "}}"
+}
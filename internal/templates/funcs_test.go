@@ -0,0 +1,146 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+)
+
+// addons is template data implementing addonSet.
+type addons []string
+
+func (a addons) HasAddon(name string) bool {
+	for _, addon := range a {
+		if addon == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDefaultValue(t *testing.T) {
+	var nilPointer *string
+	name := "app"
+
+	tests := []struct {
+		name  string
+		value any
+		want  any
+	}{
+		{name: "nil", value: nil, want: "fallback"},
+		{name: "nil pointer", value: nilPointer, want: "fallback"},
+		{name: "empty string", value: "", want: "fallback"},
+		{name: "zero int", value: 0, want: "fallback"},
+		{name: "false", value: false, want: "fallback"},
+		{name: "empty slice", value: []string(nil), want: "fallback"},
+		{name: "string", value: "set", want: "set"},
+		{name: "int", value: 8080, want: 8080},
+		{name: "true", value: true, want: true},
+		{name: "pointer", value: &name, want: &name},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := defaultValue("fallback", tt.value); got != tt.want {
+				t.Errorf("defaultValue(%#v) = %#v, want %#v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndent(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		text string
+		want string
+	}{
+		{name: "single line", n: 2, text: "a", want: "  a"},
+		{name: "blank lines stay empty", n: 4, text: "a\n\nb", want: "    a\n\n    b"},
+		{name: "trailing newline", n: 1, text: "a\n", want: " a\n"},
+		{name: "whitespace line", n: 2, text: "a\n \nb", want: "  a\n   \n  b"},
+		{name: "zero", n: 0, text: "a\nb", want: "a\nb"},
+		{name: "empty", n: 2, text: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := indent(tt.n, tt.text); got != tt.want {
+				t.Errorf("indent(%d, %q) = %q, want %q", tt.n, tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Setenv("GOCRAFTING_TEST_ENV", "set")
+	t.Setenv("GOCRAFTING_TEST_EMPTY", "")
+
+	data := struct {
+		addons
+		Name  string
+		Port  string
+		Block string
+	}{addons: addons{"docker"}, Name: "UserProfile", Block: "a\n\nb"}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{name: "raw", template: `version: {{ raw " .Version " }}`, want: "version: {{ .Version }}"},
+		{name: "raw keeps spacing", template: `{{ raw ".Tag" }}`, want: "{{.Tag}}"},
+		{name: "delimiters", template: `{{ ldelim }} .Env {{ rdelim }}`, want: "{{ .Env }}"},
+		{name: "default on empty field", template: `{{ .Port | default "8080" | quote }}`, want: `"8080"`},
+		{name: "default on set field", template: `{{ .Name | default "app" }}`, want: "UserProfile"},
+		{name: "quote escapes", template: `{{ quote "say \"hi\"" }}`, want: `"say \"hi\""`},
+		{name: "goString", template: `{{ goString "SELECT \"id\"" }} {{ goString "SELECT ` + "`id`" + `" }}`, want: "`SELECT \"id\"` \"SELECT `id`\""},
+		{name: "indent", template: `{{ indent 2 .Block }}`, want: "  a\n\n  b"},
+		{name: "case helpers", template: `{{ .Name | snake }} {{ .Name | kebab }} {{ .Name | camel }} {{ .Name | plural | snake }}`,
+			want: "user_profile user-profile userProfile user_profiles"},
+		{name: "hasAddon bound to data", template: `{{ hasAddon "docker" }} {{ hasAddon "swagger" }}`, want: "true false"},
+		{name: "dbPlaceholder", template: `{{ dbPlaceholder "PostgreSQL" 2 }} {{ dbPlaceholder "MySQL" 2 }}`, want: "$2 ?"},
+		{name: "dbQuote", template: `{{ dbQuote "PostgreSQL" "order" }} {{ dbQuote "MySQL" "order" }}`, want: "\"order\" `order`"},
+		{name: "env", template: `{{ env "GOCRAFTING_TEST_ENV" "x" }} {{ env "GOCRAFTING_TEST_EMPTY" "fallback" }} [{{ env "GOCRAFTING_TEST_UNSET" }}]`,
+			want: "set fallback []"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.name, []byte(tt.template), data)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Render(%q) = %q, want %q", tt.template, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderHasAddonWithoutAddons(t *testing.T) {
+	got, err := Render("plain", []byte(`{{ hasAddon "docker" }}`), map[string]string{})
+	if err != nil || string(got) != "false" {
+		t.Errorf("Render() = %q, %v; want false", got, err)
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{name: "parse", template: "{{ if }}", want: "failed to parse template parse"},
+		{name: "execute", template: "{{ .Missing.Field }}", want: "failed to execute template execute"},
+		{name: "unknown function", template: "{{ sprig }}", want: `function "sprig" not defined`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Render(tt.name, []byte(tt.template), struct{ Name string }{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Render(%q) error = %v, want %q", tt.template, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+)
+
+// ProjectDir is the project-local template override directory, relative to the working directory.
+var ProjectDir = filepath.Join(".gocrafting", "templates")
+
+// ConfigDir returns the user-level gocrafting directory: $XDG_CONFIG_HOME/gocrafting,
+// falling back to ~/.config/gocrafting.
+func ConfigDir() (string, error) {
+	if configHome := os.Getenv("XDG_CONFIG_HOME"); configHome != "" {
+		return filepath.Join(configHome, "gocrafting"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "gocrafting"), nil
+}
+
+// UserDir returns the user-level template override directory, ~/.config/gocrafting/templates.
+func UserDir() (string, error) {
+	configDir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "templates"), nil
+}
+
+// OverrideDirs returns the existing override directories in lookup order: project-local first, then user-level.
+func OverrideDirs() []string {
+	candidates := []string{ProjectDir}
+	if userDir, err := UserDir(); err == nil {
+		candidates = append(candidates, userDir)
+	}
+
+	var dirs []string
+	for _, dir := range candidates {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// Overlay returns defaults layered under the override directories, so a template is read from
+// .gocrafting/templates/, then ~/.config/gocrafting/templates/, then the embedded defaults,
+// using the same relative path in every layer. Directory listings merge all layers.
+func Overlay(defaults fs.FS) fs.FS {
+	var layers []fs.FS
+	for _, dir := range OverrideDirs() {
+		layers = append(layers, os.DirFS(dir))
+	}
+	if len(layers) == 0 {
+		return defaults
+	}
+	return layeredFS(append(layers, defaults))
+}
+
+// Merge combines template trees that share one relative path space, e.g. the project and schematic defaults.
+func Merge(trees ...fs.FS) fs.FS {
+	return layeredFS(trees)
+}
+
+// layeredFS resolves every path against its layers in order, the first layer that has it wins.
+type layeredFS []fs.FS
+
+// Open opens name from the first layer containing it.
+func (l layeredFS) Open(name string) (fs.File, error) {
+	for _, layer := range l {
+		file, err := layer.Open(name)
+		if err == nil {
+			return file, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// ReadDir merges the entries of name from every layer, earlier layers shadowing later ones.
+func (l layeredFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	seen := map[string]bool{}
+	var entries []fs.DirEntry
+	found := false
+
+	for _, layer := range l {
+		layerEntries, err := fs.ReadDir(layer, name)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+
+		found = true
+		for _, entry := range layerEntries {
+			if !seen[entry.Name()] {
+				seen[entry.Name()] = true
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+// Eject copies the embedded default at name (a template or a whole directory) into destDir,
+// keeping its relative path so the copy overrides the default. Existing files are kept unless force is set.
+// It returns the written files.
+func Eject(defaults fs.FS, name, destDir string, force bool) ([]string, error) {
+	name = path.Clean(filepath.ToSlash(name))
+
+	if _, err := fs.Stat(defaults, name); err != nil {
+		return nil, fmt.Errorf("template '%s' not found in the embedded defaults", name)
+	}
+
+	var written []string
+	err := fs.WalkDir(defaults, name, func(current string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		targetPath := filepath.Join(destDir, filepath.FromSlash(current))
+		if d.IsDir() {
+			return os.MkdirAll(targetPath, 0750)
+		}
+		// The embedded trees also hold the Go sources that embed them
+		if path.Ext(current) == ".go" {
+			return nil
+		}
+
+		if _, err := os.Stat(targetPath); err == nil && !force {
+			return fmt.Errorf("%s already exists, use --force to overwrite it", targetPath)
+		}
+
+		content, err := fs.ReadFile(defaults, current)
+		if err != nil {
+			return fmt.Errorf("failed to read template %s: %w", current, err)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(targetPath), 0750); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", targetPath, err)
+		}
+
+		if err := os.WriteFile(targetPath, content, 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", targetPath, err)
+		}
+
+		written = append(written, targetPath)
+		return nil
+	})
+
+	return written, err
+}
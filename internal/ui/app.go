@@ -31,6 +31,12 @@ const (
 	// StateSelectDatabaseDriver is the stage where user selects the database type.
 	StateSelectDatabaseDriver
 
+	// StateSelectTestingFramework is the stage where user selects the style of generated tests.
+	StateSelectTestingFramework
+
+	// StateSelectLogger is the stage where user selects the logging library.
+	StateSelectLogger
+
 	// StateSelectAddons is the stage where user selects additional features.
 	StateSelectAddons
 
@@ -56,13 +62,15 @@ var AddonList = []string{
 // MainModel is the main struct that stores all TUI application data.
 type MainModel struct {
 	// Data Fields
-	ProjectName            string
-	ModuleName             string
-	ProjectScale           string
-	SelectedTemplate       string
-	SelectedFramework      string
-	SelectedDatabaseDriver string
-	SelectedAddonsIndices  map[int]bool
+	ProjectName              string
+	ModuleName               string
+	ProjectScale             string
+	SelectedTemplate         string
+	SelectedFramework        string
+	SelectedDatabaseDriver   string
+	SelectedTestingFramework string
+	SelectedLogger           string
+	SelectedAddonsIndices    map[int]bool
 
 	// State & UI Fields
 	SelectedOption     int
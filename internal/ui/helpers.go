@@ -15,13 +15,15 @@ func (uiModel MainModel) reconstructConfig() core.ProjectConfig {
 	}
 
 	return core.ProjectConfig{
-		ProjectName:            uiModel.ProjectName,
-		ModuleName:             uiModel.ModuleName,
-		ProjectScale:           uiModel.ProjectScale,
-		SelectedTemplate:       uiModel.SelectedTemplate,
-		SelectedFramework:      uiModel.SelectedFramework,
-		SelectedDatabaseDriver: uiModel.SelectedDatabaseDriver,
-		SelectedAddons:         selectedAddons,
+		ProjectName:              uiModel.ProjectName,
+		ModuleName:               uiModel.ModuleName,
+		ProjectScale:             uiModel.ProjectScale,
+		SelectedTemplate:         uiModel.SelectedTemplate,
+		SelectedFramework:        uiModel.SelectedFramework,
+		SelectedDatabaseDriver:   uiModel.SelectedDatabaseDriver,
+		SelectedTestingFramework: uiModel.SelectedTestingFramework,
+		SelectedLogger:           uiModel.SelectedLogger,
+		SelectedAddons:           selectedAddons,
 	}
 }
 
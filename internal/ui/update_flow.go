@@ -2,6 +2,7 @@ package ui
 
 import (
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/xRiot45/gocrafting/internal/core"
 	"github.com/xRiot45/gocrafting/internal/generators"
 )
 
@@ -85,11 +86,27 @@ func (m MainModel) handleEnter() (tea.Model, tea.Cmd) {
 		dbs := provider.GetDatabaseDrivers(m.SelectedTemplate)
 		m.SelectedDatabaseDriver = dbs[m.SelectedOption]
 
+		m.CurrentState = StateSelectTestingFramework
+		m.SelectedOption = 0
+		return m, nil
+
+	// STEP 7: Select Testing Framework
+	case StateSelectTestingFramework:
+		m.SelectedTestingFramework = core.TestingFrameworks[m.SelectedOption]
+
+		m.CurrentState = StateSelectLogger
+		m.SelectedOption = 0
+		return m, nil
+
+	// STEP 8: Select Logger
+	case StateSelectLogger:
+		m.SelectedLogger = core.Loggers[m.SelectedOption]
+
 		m.CurrentState = StateSelectAddons
 		m.SelectedOption = 0
 		return m, nil
 
-	// STEP 7: Select Addons (Final)
+	// STEP 9: Select Addons (Final)
 	case StateSelectAddons:
 		return m.triggerInstall()
 	}
@@ -112,7 +129,7 @@ func (m MainModel) checkDatabaseStep() (tea.Model, tea.Cmd) {
 
 	// Jika tidak (Skip Database)
 	m.SelectedDatabaseDriver = "None"
-	m.CurrentState = StateSelectAddons
+	m.CurrentState = StateSelectTestingFramework
 	m.SelectedOption = 0
 	return m, nil
 }
@@ -24,6 +24,20 @@ func (m MainModel) handleNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	// --- NAVIGASI ATAS ---
 	case tea.KeyUp:
+		if m.CurrentState == StateSelectTemplate {
+			// Lompati template yang disabled (template pack bisa berada di bawahnya)
+			if provider, _ := generators.GetProvider(m.ProjectScale); provider != nil {
+				templates := provider.GetTemplates()
+				for prev := m.SelectedOption - 1; prev >= 0; prev-- {
+					if !isDisabledTemplate(templates[prev]) {
+						m.SelectedOption = prev
+						break
+					}
+				}
+			}
+			return m, nil
+		}
+
 		if m.SelectedOption > 0 {
 			m.SelectedOption--
 		}
@@ -52,15 +66,16 @@ func (m MainModel) handleNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		case StateSelectTemplate:
 			if provider != nil {
+				// Lompati template yang disabled agar template pack di bawahnya tetap bisa dipilih
 				templates := provider.GetTemplates()
-				maxIndex = len(templates) - 1
-
-				if m.SelectedOption < maxIndex {
-					if isDisabledTemplate(templates[m.SelectedOption+1]) {
-						return m, nil
+				for next := m.SelectedOption + 1; next < len(templates); next++ {
+					if !isDisabledTemplate(templates[next]) {
+						m.SelectedOption = next
+						break
 					}
 				}
 			}
+			return m, nil
 
 		case StateSelectFramework:
 			if provider != nil {
@@ -72,6 +87,12 @@ func (m MainModel) handleNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				maxIndex = len(provider.GetDatabaseDrivers(m.SelectedTemplate)) - 1
 			}
 
+		case StateSelectTestingFramework:
+			maxIndex = len(core.TestingFrameworks) - 1
+
+		case StateSelectLogger:
+			maxIndex = len(core.Loggers) - 1
+
 		case StateSelectAddons:
 			maxIndex = len(core.AvailableAddons) - 1
 		}
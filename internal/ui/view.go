@@ -50,6 +50,8 @@ func renderSidebar(m MainModel) string {
 		{StateSelectTemplate, "Template"},
 		{StateSelectFramework, "Framework"},
 		{StateSelectDatabaseDriver, "Database"},
+		{StateSelectTestingFramework, "Testing"},
+		{StateSelectLogger, "Logging"},
 		{StateSelectAddons, "Add-ons"},
 		{StateInstalling, "Installation"},
 	}
@@ -105,7 +107,7 @@ func renderMainContent(m MainModel) string {
 		s.WriteString(m.TextInputComponent.View())
 
 	// --- SINGLE SELECTIONS (Scale, Template, Framework, DB) ---
-	case StateSelectProjectScale, StateSelectTemplate, StateSelectFramework, StateSelectDatabaseDriver:
+	case StateSelectProjectScale, StateSelectTemplate, StateSelectFramework, StateSelectDatabaseDriver, StateSelectTestingFramework, StateSelectLogger:
 		var title, subtitle string
 		var options []string
 
@@ -134,6 +136,14 @@ func renderMainContent(m MainModel) string {
 			if provider != nil {
 				options = provider.GetDatabaseDrivers(m.SelectedTemplate)
 			}
+		case StateSelectTestingFramework:
+			title = "TESTING STYLE"
+			subtitle = "How should generated tests be written?"
+			options = core.TestingFrameworks
+		case StateSelectLogger:
+			title = "LOGGING"
+			subtitle = "Which library should back the generated logger?"
+			options = core.Loggers
 		}
 
 		s.WriteString(HeaderStyle.Render(title))
@@ -0,0 +1,308 @@
+// Package verify generates project combinations into temporary directories and checks that each
+// one passes 'go vet' and 'go build', resolving dependencies offline from the module cache or a proxy directory.
+package verify
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/xRiot45/gocrafting/internal/core"
+	"github.com/xRiot45/gocrafting/internal/generators"
+	"github.com/xRiot45/gocrafting/internal/lint"
+	"github.com/xRiot45/gocrafting/internal/packs"
+	"github.com/xRiot45/gocrafting/internal/shell"
+	"github.com/xRiot45/gocrafting/internal/templates"
+)
+
+// projectName is the folder every combination is generated into, inside its own directory,
+// so compiler output names the same paths for every combination and equal failures group together.
+const projectName = "verify-app"
+
+// Options selects the combinations to verify and where dependencies come from.
+type Options struct {
+	// Templates, Frameworks, Databases, Testing and Loggers keep only the combinations using one of
+	// the listed values (case-insensitive); an empty list keeps all of them.
+	Templates  []string
+	Frameworks []string
+	Databases  []string
+	Testing    []string
+	Loggers    []string
+	// Proxy is a directory laid out like a module proxy (GOPROXY=file://<Proxy>).
+	// When empty, modules come from a GOPROXY=file:// directory or else the local module cache.
+	Proxy string
+	// Jobs is the number of projects checked in parallel, at least 1.
+	Jobs int
+	// Keep leaves the generated projects on disk for inspection.
+	Keep bool
+}
+
+// Failure is a generation or compile failure, reported once for every combination failing the same way.
+type Failure struct {
+	// Stage is the step that failed: "generate", "vet" or "build".
+	Stage string
+	// Output is the error message or the output of the failing command.
+	Output string
+	// Configs are the combinations failing with this output.
+	Configs []core.ProjectConfig
+}
+
+// Report is the result of verifying the selected combinations.
+type Report struct {
+	// Combinations is the number of combinations generated and checked.
+	Combinations int
+	// Passed is the number of combinations that generated, vetted and built.
+	Passed int
+	// Failures are the failures found, in the order of the combinations.
+	Failures []Failure
+	// Skipped lists templates offered by a provider that have no template files.
+	Skipped []string
+	// Dir is the directory holding the generated projects, removed unless Options.Keep is set.
+	Dir string
+}
+
+// result is the outcome of one combination.
+type result struct {
+	config core.ProjectConfig
+	dir    string
+	stage  string
+	output string
+}
+
+// Run generates every selected combination and runs 'go vet ./...' and 'go build ./...' on it.
+// progress, when not nil, is called after each combination with an empty stage when it passed.
+func Run(options Options, progress func(config core.ProjectConfig, stage string)) (Report, error) {
+	var report Report
+
+	executor, err := newExecutor(options.Proxy)
+	if err != nil {
+		return report, err
+	}
+	defer shell.SetExecutor(executor)()
+
+	combinations, skipped := Select(options)
+	report.Combinations = len(combinations)
+	report.Skipped = skipped
+
+	root, err := os.MkdirTemp("", "gocrafting-verify-*")
+	if err != nil {
+		return report, fmt.Errorf("failed to create verify directory: %w", err)
+	}
+	report.Dir = root
+	if !options.Keep {
+		defer func() { _ = os.RemoveAll(root) }()
+	}
+
+	// Project-local overrides and packs are looked up relative to the working directory,
+	// which generate changes, so they are pinned to absolute paths for the run
+	restore, err := absoluteProjectDirs()
+	if err != nil {
+		return report, err
+	}
+	defer restore()
+
+	// 1. VET & BUILD WORKERS (parallel: the Go build cache is safe for concurrent use)
+	results := make([]result, len(combinations))
+	indexes := make(chan int)
+	done := make(chan int, len(results))
+	var wg sync.WaitGroup
+	for range max(options.Jobs, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i].stage, results[i].output = check(executor, filepath.Join(results[i].dir, projectName))
+				done <- i
+			}
+		}()
+	}
+
+	// progress is only called from this goroutine, outside of generate, which discards standard output
+	finished := func(i int) {
+		if progress != nil {
+			progress(results[i].config, results[i].stage)
+		}
+	}
+
+	// 2. GENERATE (sequential: generators write relative to the working directory)
+	for i, config := range combinations {
+		results[i] = result{config: config, dir: filepath.Join(root, fmt.Sprintf("%03d", i+1))}
+		if err := generate(results[i].dir, config); err != nil {
+			results[i].stage, results[i].output = "generate", strings.TrimSpace(err.Error())
+			finished(i)
+		} else {
+			indexes <- i
+		}
+
+		for drained := false; !drained; {
+			select {
+			case j := <-done:
+				finished(j)
+			default:
+				drained = true
+			}
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	close(done)
+	for j := range done {
+		finished(j)
+	}
+
+	// 3. GROUP FAILURES
+	failures := map[string]int{}
+	for _, r := range results {
+		if r.stage == "" {
+			report.Passed++
+			continue
+		}
+
+		key := r.stage + "\x00" + r.output
+		if index, ok := failures[key]; ok {
+			report.Failures[index].Configs = append(report.Failures[index].Configs, r.config)
+			continue
+		}
+		failures[key] = len(report.Failures)
+		report.Failures = append(report.Failures, Failure{Stage: r.stage, Output: r.output, Configs: []core.ProjectConfig{r.config}})
+	}
+
+	return report, nil
+}
+
+// Select returns the combinations matching options, and notes for templates without files.
+func Select(options Options) ([]core.ProjectConfig, []string) {
+	var selected []core.ProjectConfig
+	var skipped []string
+	noted := map[string]bool{}
+
+	for _, config := range lint.Combinations() {
+		if !matches(options.Templates, config.SelectedTemplate) ||
+			!matches(options.Frameworks, config.SelectedFramework) ||
+			!matches(options.Databases, config.SelectedDatabaseDriver) ||
+			!matches(options.Testing, config.TestingFramework()) ||
+			!matches(options.Loggers, config.SelectedLogger) {
+			continue
+		}
+
+		if !hasFiles(config) {
+			note := fmt.Sprintf("%s / %s: no template files", config.ProjectScale, config.SelectedTemplate)
+			if !noted[note] {
+				noted[note] = true
+				skipped = append(skipped, note)
+			}
+			continue
+		}
+
+		config.ProjectName = projectName
+		config.ModuleName = "example.com/" + projectName
+		selected = append(selected, config)
+	}
+	return selected, skipped
+}
+
+// matches reports whether value is one of values, ignoring case; an empty list matches everything.
+func matches(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasFiles reports whether the template of config can be generated: a built-in folder or a template pack.
+func hasFiles(config core.ProjectConfig) bool {
+	provider, err := generators.GetProvider(config.ProjectScale)
+	if err != nil {
+		return false
+	}
+
+	if dir := provider.GetTemplateDir(config.SelectedTemplate); dir != "" {
+		_, err := fs.Stat(templates.FS, dir)
+		return err == nil
+	}
+
+	_, ok := packs.Find(config.ProjectScale, config.SelectedTemplate)
+	return ok
+}
+
+// absoluteProjectDirs makes the project-local template and pack directories absolute
+// and returns a function restoring them.
+func absoluteProjectDirs() (func(), error) {
+	templatesDir, err := filepath.Abs(templates.ProjectDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", templates.ProjectDir, err)
+	}
+	packsDir, err := filepath.Abs(packs.ProjectDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", packs.ProjectDir, err)
+	}
+
+	previousTemplates, previousPacks := templates.ProjectDir, packs.ProjectDir
+	templates.ProjectDir, packs.ProjectDir = templatesDir, packsDir
+	return func() { templates.ProjectDir, packs.ProjectDir = previousTemplates, previousPacks }, nil
+}
+
+// generate creates the project of config inside dir. Generator progress messages are discarded.
+func generate(dir string, config core.ProjectConfig) error {
+	provider, err := generators.GetProvider(config.ProjectScale)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		return fmt.Errorf("failed to enter %s: %w", dir, err)
+	}
+	defer func() { _ = os.Chdir(wd) }()
+
+	return quiet(func() error { return provider.Generate(config) })
+}
+
+// quiet runs fn with standard output discarded.
+func quiet(fn func() error) error {
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		return fn()
+	}
+	defer func() { _ = devNull.Close() }()
+
+	stdout := os.Stdout
+	os.Stdout = devNull
+	defer func() { os.Stdout = stdout }()
+
+	return fn()
+}
+
+// check runs 'go vet ./...' then 'go build ./...' in projectDir, returning the failing stage and its output.
+func check(executor shell.Executor, projectDir string) (string, string) {
+	for _, stage := range []string{"vet", "build"} {
+		if output, err := executor.Run(projectDir, "go", stage, "./..."); err != nil {
+			if len(output) == 0 {
+				output = []byte(err.Error())
+			}
+			return stage, strings.TrimSpace(string(output))
+		}
+	}
+	return "", ""
+}
+
+// newExecutor returns the executor running the generator and the checks offline, with the versions
+// pinned in the dependency registry, from the proxy directory or else the module cache.
+func newExecutor(proxy string) (shell.Executor, error) {
+	return shell.NewOfflineExecutor(proxy, core.PinnedVersions())
+}